@@ -0,0 +1,357 @@
+// Package alert evaluates rules against inverter reads and notifies sinks
+// when they fire and resolve.
+package alert
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/freman/sungrow"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultSendTimeout = 10 * time.Second
+
+// Config is the YAML alerting configuration, eg
+//
+//	rules:
+//	  - name: battery_hot
+//	    condition: "battery_temperature > 45"
+//	    clear: "battery_temperature < 42"
+//	    for: 5m
+//	    repeat: 1h
+//	  - name: off_grid
+//	    condition: 'grid_state == "off-grid"'
+//	  - name: no_data
+//	    stale: 10m
+//	sinks:
+//	  - type: ntfy
+//	    url: https://ntfy.sh/my-inverter
+type Config struct {
+	Rules []Rule       `yaml:"rules"`
+	Sinks []SinkConfig `yaml:"sinks"`
+}
+
+// Rule fires when its condition holds for long enough, or when there hasn't
+// been a successful read for Stale.
+type Rule struct {
+	Name string `yaml:"name"`
+	// Expression over register names, see sungrow.Expression, registers can
+	// be compared with quoted labels of their values
+	Condition string `yaml:"condition,omitempty"`
+	// Resolves the rule when true, defaults to the condition being false
+	Clear string `yaml:"clear,omitempty"`
+	// How long the condition must hold before firing
+	For time.Duration `yaml:"for,omitempty"`
+	// Notify again while firing this often, zero notifies once
+	Repeat time.Duration `yaml:"repeat,omitempty"`
+	// Fire when there's been no successful read for this long
+	Stale    time.Duration `yaml:"stale,omitempty"`
+	Severity string        `yaml:"severity,omitempty"`
+	// text/template rendered with the Notification
+	Message string `yaml:"message,omitempty"`
+}
+
+// State of a notification
+type State string
+
+const (
+	StateFiring   State = "firing"
+	StateResolved State = "resolved"
+)
+
+// Notification is sent to the sinks.
+type Notification struct {
+	Rule     string             `json:"rule"`
+	State    State              `json:"state"`
+	Severity string             `json:"severity,omitempty"`
+	Message  string             `json:"message"`
+	Time     time.Time          `json:"time"`
+	Values   map[string]float64 `json:"values,omitempty"`
+}
+
+// Title is a one line summary of the notification.
+func (n Notification) Title() string {
+	return fmt.Sprintf("[%s] %s", n.State, n.Rule)
+}
+
+// Sink delivers notifications.
+type Sink interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// LoadConfig reads a configuration.
+func LoadConfig(r io.Reader) (*Config, error) {
+	var cfg Config
+	if err := yaml.NewDecoder(r).Decode(&cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// LoadConfigFile reads a configuration file.
+func LoadConfigFile(name string) (*Config, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return LoadConfig(f)
+}
+
+// Engine tracks the rules across reads.
+type Engine struct {
+	// How long to wait for each sink
+	SendTimeout time.Duration
+	// Delivery failures are logged here
+	Logger *log.Logger
+
+	mu          sync.Mutex
+	rules       []*rule
+	sinks       []Sink
+	lastSuccess time.Time
+}
+
+type rule struct {
+	Rule
+	condition *sungrow.Expression
+	clear     *sungrow.Expression
+	message   *template.Template
+
+	pendingSince time.Time
+	firing       bool
+	notified     time.Time
+}
+
+// NewEngine validates the rules and builds the sinks. Labels in conditions
+// are resolved from the values of the registers defined in def, without one
+// registers can only be compared with numbers.
+func NewEngine(cfg *Config, def *sungrow.Inverter) (*Engine, error) {
+	e := &Engine{SendTimeout: defaultSendTimeout}
+
+	parse := sungrow.ParseExpression
+	if def != nil {
+		parse = def.ParseExpression
+	}
+
+	for _, r := range cfg.Rules {
+		compiled, err := compile(r, parse)
+		if err != nil {
+			return nil, err
+		}
+		e.rules = append(e.rules, compiled)
+	}
+
+	for _, sc := range cfg.Sinks {
+		s, err := sc.Build()
+		if err != nil {
+			return nil, err
+		}
+		e.sinks = append(e.sinks, s)
+	}
+
+	return e, nil
+}
+
+func compile(r Rule, parse func(string) (*sungrow.Expression, error)) (*rule, error) {
+	c := &rule{Rule: r}
+
+	if r.Name == "" {
+		return nil, errors.New("rule without a name")
+	}
+
+	if (r.Condition == "") == (r.Stale == 0) {
+		return nil, fmt.Errorf("rule %q needs one of condition or stale", r.Name)
+	}
+
+	var err error
+	if r.Condition != "" {
+		if c.condition, err = parse(r.Condition); err != nil {
+			return nil, fmt.Errorf("rule %q: %w", r.Name, err)
+		}
+	}
+
+	if r.Clear != "" {
+		if c.clear, err = parse(r.Clear); err != nil {
+			return nil, fmt.Errorf("rule %q: %w", r.Name, err)
+		}
+	}
+
+	if r.Message != "" {
+		if c.message, err = template.New(r.Name).Parse(r.Message); err != nil {
+			return nil, fmt.Errorf("rule %q: %w", r.Name, err)
+		}
+	}
+
+	return c, nil
+}
+
+// AddSink adds a sink to deliver to.
+func (e *Engine) AddSink(s Sink) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.sinks = append(e.sinks, s)
+}
+
+// Observe evaluates the rules after a read at now, readErr being the error
+// the read returned. It returns the notifications sent.
+func (e *Engine) Observe(inv *sungrow.Inverter, readErr error, now time.Time) []Notification {
+	e.mu.Lock()
+
+	if e.lastSuccess.IsZero() || readErr == nil {
+		e.lastSuccess = now
+	}
+
+	var sent []Notification
+	for _, r := range e.rules {
+		var n *Notification
+		if r.Stale > 0 {
+			n = e.stale(r, now)
+		} else if readErr == nil {
+			n = r.evaluate(inv, now)
+		}
+
+		if n != nil {
+			sent = append(sent, *n)
+		}
+	}
+
+	sinks := e.sinks
+	e.mu.Unlock()
+
+	for _, n := range sent {
+		e.send(sinks, n)
+	}
+
+	return sent
+}
+
+func (e *Engine) stale(r *rule, now time.Time) *Notification {
+	age := now.Sub(e.lastSuccess)
+
+	switch {
+	case !r.firing && age >= r.Stale:
+		r.firing = true
+		r.notified = now
+		return r.notification(StateFiring, now, nil)
+	case r.firing && age < r.Stale:
+		r.firing = false
+		return r.notification(StateResolved, now, nil)
+	case r.firing && r.Repeat > 0 && now.Sub(r.notified) >= r.Repeat:
+		r.notified = now
+		return r.notification(StateFiring, now, nil)
+	}
+
+	return nil
+}
+
+func (r *rule) evaluate(inv *sungrow.Inverter, now time.Time) *Notification {
+	values := map[string]float64{}
+	lookup := func(name string) (float64, bool) {
+		v, isa := inv.Value(name)
+		if isa {
+			values[name] = v
+		}
+		return v, isa
+	}
+
+	if r.firing {
+		var resolved bool
+		if r.clear != nil {
+			v, err := r.clear.Eval(lookup)
+			if err != nil {
+				return nil
+			}
+			resolved = v != 0
+		} else {
+			v, err := r.condition.Eval(lookup)
+			if err != nil {
+				return nil
+			}
+			resolved = v == 0
+		}
+
+		if resolved {
+			r.firing = false
+			r.pendingSince = time.Time{}
+			return r.notification(StateResolved, now, values)
+		}
+
+		if r.Repeat > 0 && now.Sub(r.notified) >= r.Repeat {
+			r.notified = now
+			return r.notification(StateFiring, now, values)
+		}
+
+		return nil
+	}
+
+	v, err := r.condition.Eval(lookup)
+	if err != nil {
+		// Can't tell, leave things as they are
+		return nil
+	}
+
+	if v == 0 {
+		r.pendingSince = time.Time{}
+		return nil
+	}
+
+	if r.pendingSince.IsZero() {
+		r.pendingSince = now
+	}
+
+	if now.Sub(r.pendingSince) < r.For {
+		return nil
+	}
+
+	r.firing = true
+	r.notified = now
+	return r.notification(StateFiring, now, values)
+}
+
+func (r *rule) notification(state State, now time.Time, values map[string]float64) *Notification {
+	n := &Notification{
+		Rule:     r.Name,
+		State:    state,
+		Severity: r.Severity,
+		Time:     now,
+		Values:   values,
+	}
+
+	switch {
+	case r.message != nil:
+		var buf bytes.Buffer
+		if err := r.message.Execute(&buf, n); err != nil {
+			n.Message = fmt.Sprintf("%s: %v", r.Name, err)
+		} else {
+			n.Message = buf.String()
+		}
+	case r.Stale > 0:
+		n.Message = fmt.Sprintf("no successful read for %s", r.Stale)
+	default:
+		n.Message = r.Condition
+	}
+
+	return n
+}
+
+func (e *Engine) send(sinks []Sink, n Notification) {
+	for _, s := range sinks {
+		ctx, cancel := context.WithTimeout(context.Background(), e.SendTimeout)
+		if err := s.Notify(ctx, n); err != nil && e.Logger != nil {
+			e.Logger.Printf("alert: failed to deliver %s: %v", n.Title(), err)
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,302 @@
+package alert_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/alert"
+	"github.com/freman/sungrow/internal/modbustest"
+	"github.com/stretchr/testify/require"
+)
+
+const registers = `registers:
+  input:
+    - address: 13025
+      name: "battery_temperature"
+      unit: "℃"
+      scale: 0.1
+      type: "int16"
+    - address: 13030
+      name: "grid_state"
+      values:
+        0xAA: "Off grid"
+        0x55: "On grid"
+`
+
+func read(t *testing.T, temperature, gridState uint16) *sungrow.Inverter {
+	return modbustest.Read(t, registers, map[uint16]uint16{13024: temperature, 13029: gridState})
+}
+
+const config = `rules:
+  - name: battery_hot
+    condition: "battery_temperature > 45"
+    clear: "battery_temperature < 42"
+    for: 5m
+    repeat: 1h
+    severity: high
+    message: "Battery at {{index .Values \"battery_temperature\"}}℃"
+  - name: off_grid
+    condition: 'grid_state == "off-grid"'
+  - name: no_data
+    stale: 10m
+`
+
+type recorder []alert.Notification
+
+func (r *recorder) Notify(_ context.Context, n alert.Notification) error {
+	*r = append(*r, n)
+	return nil
+}
+
+func TestRules(t *testing.T) {
+	requires := require.New(t)
+
+	cfg, err := alert.LoadConfig(strings.NewReader(config))
+	requires.NoError(err)
+	requires.Equal(5*time.Minute, cfg.Rules[0].For)
+
+	var def sungrow.Inverter
+	requires.NoError(def.Define(strings.NewReader(registers)))
+
+	engine, err := alert.NewEngine(cfg, &def)
+	requires.NoError(err)
+
+	var got recorder
+	engine.AddSink(&got)
+
+	start := time.Date(2023, 11, 4, 13, 37, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+
+	requires.Empty(engine.Observe(read(t, 400, 0x55), nil, at(0)))
+
+	// Too hot, but not for long enough
+	requires.Empty(engine.Observe(read(t, 460, 0x55), nil, at(time.Minute)))
+	requires.Empty(engine.Observe(read(t, 440, 0x55), nil, at(2*time.Minute)))
+	requires.Empty(engine.Observe(read(t, 460, 0x55), nil, at(3*time.Minute)))
+	requires.Empty(engine.Observe(read(t, 470, 0x55), nil, at(7*time.Minute)))
+
+	sent := engine.Observe(read(t, 470, 0xAA), nil, at(8*time.Minute))
+	requires.Len(sent, 2)
+	requires.Equal(alert.StateFiring, sent[0].State)
+	requires.Equal("battery_hot", sent[0].Rule)
+	requires.Equal("Battery at 47℃", sent[0].Message)
+	requires.Equal("off_grid", sent[1].Rule)
+	requires.Equal(`grid_state == "off-grid"`, sent[1].Message)
+
+	// Hysteresis, cooling below the condition doesn't resolve it
+	requires.Empty(engine.Observe(read(t, 440, 0xAA), nil, at(9*time.Minute)))
+
+	// Repeats while still firing
+	sent = engine.Observe(read(t, 430, 0xAA), nil, at(68*time.Minute))
+	requires.Len(sent, 1)
+	requires.Equal("[firing] battery_hot", sent[0].Title())
+
+	sent = engine.Observe(read(t, 410, 0x55), nil, at(69*time.Minute))
+	requires.Len(sent, 2)
+	requires.Equal(alert.StateResolved, sent[0].State)
+	requires.Equal(alert.StateResolved, sent[1].State)
+
+	// Failed reads don't evaluate conditions but do go stale
+	requires.Empty(engine.Observe(nil, errors.New("timeout"), at(75*time.Minute)))
+	sent = engine.Observe(nil, errors.New("timeout"), at(80*time.Minute))
+	requires.Len(sent, 1)
+	requires.Equal("no_data", sent[0].Rule)
+	requires.Equal("no successful read for 10m0s", sent[0].Message)
+
+	sent = engine.Observe(read(t, 410, 0x55), nil, at(81*time.Minute))
+	requires.Len(sent, 1)
+	requires.Equal(alert.StateResolved, sent[0].State)
+
+	requires.Len(got, 7)
+
+	for _, bad := range []string{
+		"rules:\n  - name: x\n",
+		"rules:\n  - condition: \"1\"\n",
+		"rules:\n  - name: x\n    condition: \"1 +\"\n",
+		"sinks:\n  - type: pager\n",
+		"rules:\n  - name: x\n    condition: 'grid_state == \"islanded\"'\n",
+	} {
+		cfg, err := alert.LoadConfig(strings.NewReader(bad))
+		requires.NoError(err)
+		_, err = alert.NewEngine(cfg, &def)
+		requires.Error(err, bad)
+	}
+
+	// Labels need the definition
+	cfg, err = alert.LoadConfig(strings.NewReader(config))
+	requires.NoError(err)
+	_, err = alert.NewEngine(cfg, nil)
+	requires.Error(err)
+}
+
+func TestHTTPSinks(t *testing.T) {
+	requires := require.New(t)
+
+	requests := make(chan *http.Request, 2)
+	bodies := make(chan string, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests <- r
+		bodies <- string(b)
+	}))
+	defer srv.Close()
+
+	n := alert.Notification{
+		Rule:     "battery_hot",
+		State:    alert.StateFiring,
+		Severity: "high",
+		Message:  "Battery at 47℃",
+		Time:     time.Date(2023, 11, 4, 13, 37, 0, 0, time.UTC),
+		Values:   map[string]float64{"battery_temperature": 47},
+	}
+
+	webhook, err := alert.SinkConfig{Type: "webhook", URL: srv.URL + "/hook", Headers: map[string]string{"X-Key": "secret"}}.Build()
+	requires.NoError(err)
+	requires.NoError(webhook.Notify(context.Background(), n))
+
+	r := <-requests
+	requires.Equal("/hook", r.URL.Path)
+	requires.Equal("secret", r.Header.Get("X-Key"))
+	var decoded alert.Notification
+	requires.NoError(json.Unmarshal([]byte(<-bodies), &decoded))
+	requires.Equal(n, decoded)
+
+	ntfy, err := alert.SinkConfig{Type: "ntfy", URL: srv.URL + "/inverter", Token: "tk"}.Build()
+	requires.NoError(err)
+	requires.NoError(ntfy.Notify(context.Background(), n))
+
+	r = <-requests
+	requires.Equal("/inverter", r.URL.Path)
+	requires.Equal("[firing] battery_hot", r.Header.Get("Title"))
+	requires.Equal("high", r.Header.Get("Priority"))
+	requires.Equal("Bearer tk", r.Header.Get("Authorization"))
+	requires.Equal("Battery at 47℃", <-bodies)
+
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer broken.Close()
+
+	requires.Error((&alert.Webhook{URL: broken.URL}).Notify(context.Background(), n))
+}
+
+// fakeSMTP accepts a single message and hands back its data.
+func fakeSMTP(t *testing.T) (string, <-chan string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	data := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		rw := bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
+		reply := func(s string) {
+			rw.WriteString(s + "\r\n")
+			rw.Flush()
+		}
+
+		reply("220 localhost ready")
+		for {
+			line, err := rw.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			switch cmd := strings.ToUpper(strings.Fields(line)[0]); cmd {
+			case "EHLO", "HELO", "MAIL", "RCPT", "RSET", "NOOP":
+				reply("250 ok")
+			case "DATA":
+				reply("354 go ahead")
+				var msg strings.Builder
+				for {
+					l, err := rw.ReadString('\n')
+					if err != nil || l == ".\r\n" {
+						break
+					}
+					msg.WriteString(l)
+				}
+				data <- msg.String()
+				reply("250 queued")
+			case "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("502 unknown")
+			}
+		}
+	}()
+
+	return l.Addr().String(), data
+}
+
+func TestSMTPSink(t *testing.T) {
+	requires := require.New(t)
+
+	addr, data := fakeSMTP(t)
+
+	sink, err := alert.SinkConfig{Type: "smtp", Address: addr, From: "inverter@example.com", To: []string{"me@example.com"}}.Build()
+	requires.NoError(err)
+
+	requires.NoError(sink.Notify(context.Background(), alert.Notification{
+		Rule:    "off_grid",
+		State:   alert.StateResolved,
+		Message: "grid_state == 0xAA",
+		Time:    time.Date(2023, 11, 4, 13, 37, 0, 0, time.UTC),
+	}))
+
+	msg := <-data
+	requires.Contains(msg, "Subject: [resolved] off_grid\r\n")
+	requires.Contains(msg, "To: me@example.com\r\n")
+	requires.Contains(msg, "\r\n\r\ngrid_state == 0xAA\r\n")
+
+	_, err = alert.SinkConfig{Type: "smtp", Address: addr}.Build()
+	requires.Error(err)
+}
+
+func TestSMTPTimeout(t *testing.T) {
+	requires := require.New(t)
+
+	// Accepts and never says hello
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	requires.NoError(err)
+	defer l.Close()
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err == nil {
+			accepted <- conn
+		}
+	}()
+
+	sink := &alert.SMTP{Address: l.Addr().String(), From: "inverter@example.com", To: []string{"me@example.com"}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	requires.ErrorIs(sink.Notify(ctx, alert.Notification{Rule: "off_grid"}), context.DeadlineExceeded)
+	requires.Less(time.Since(start), time.Second)
+
+	// The connection is closed rather than left to the server
+	conn := <-accepted
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, err = conn.Read(make([]byte, 1))
+	requires.ErrorIs(err, io.EOF)
+}
@@ -0,0 +1,241 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SinkConfig describes a sink in the configuration, which fields apply depends on the type.
+type SinkConfig struct {
+	// webhook, smtp or ntfy
+	Type string `yaml:"type"`
+	// Webhook and ntfy endpoint, for ntfy including the topic
+	URL     string            `yaml:"url,omitempty"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// ntfy access token
+	Token string `yaml:"token,omitempty"`
+	// SMTP server as host:port
+	Address  string   `yaml:"address,omitempty"`
+	From     string   `yaml:"from,omitempty"`
+	To       []string `yaml:"to,omitempty"`
+	Username string   `yaml:"username,omitempty"`
+	Password string   `yaml:"password,omitempty"`
+}
+
+// Build creates the sink.
+func (c SinkConfig) Build() (Sink, error) {
+	switch c.Type {
+	case "webhook":
+		if c.URL == "" {
+			return nil, fmt.Errorf("webhook sink needs a url")
+		}
+		return &Webhook{URL: c.URL, Headers: c.Headers}, nil
+	case "ntfy":
+		if c.URL == "" {
+			return nil, fmt.Errorf("ntfy sink needs a url")
+		}
+		return &Ntfy{URL: c.URL, Token: c.Token}, nil
+	case "smtp":
+		if c.Address == "" || c.From == "" || len(c.To) == 0 {
+			return nil, fmt.Errorf("smtp sink needs an address, from and to")
+		}
+		return &SMTP{Address: c.Address, From: c.From, To: c.To, Username: c.Username, Password: c.Password}, nil
+	}
+
+	return nil, fmt.Errorf("unknown sink type %q", c.Type)
+}
+
+// Webhook posts the notification as JSON.
+type Webhook struct {
+	URL     string
+	Headers map[string]string
+	Client  *http.Client
+}
+
+func (w *Webhook) Notify(ctx context.Context, n Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.Headers {
+		req.Header.Set(k, v)
+	}
+
+	return do(w.Client, req)
+}
+
+// Ntfy publishes the notification to an ntfy style topic.
+type Ntfy struct {
+	URL    string
+	Token  string
+	Client *http.Client
+}
+
+func (s *Ntfy) Notify(ctx context.Context, n Notification) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, strings.NewReader(n.Message))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Title", n.Title())
+	req.Header.Set("Tags", string(n.State))
+	if n.State == StateFiring && (n.Severity == "critical" || n.Severity == "high") {
+		req.Header.Set("Priority", "high")
+	}
+	if s.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.Token)
+	}
+
+	return do(s.Client, req)
+}
+
+func do(client *http.Client, req *http.Request) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s responded %s", req.URL.Host, resp.Status)
+	}
+
+	return nil
+}
+
+// SMTP emails the notification.
+type SMTP struct {
+	Address  string
+	From     string
+	To       []string
+	Username string
+	Password string
+}
+
+func (s *SMTP) Notify(ctx context.Context, n Notification) error {
+	var auth smtp.Auth
+	if s.Username != "" {
+		host, _, err := net.SplitHostPort(s.Address)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", s.Username, s.Password, host)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", s.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(s.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", n.Title())
+	fmt.Fprintf(&msg, "Date: %s\r\n", n.Time.Format("Mon, 02 Jan 2006 15:04:05 -0700"))
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s\r\n", n.Message)
+
+	return s.send(ctx, auth, msg.Bytes())
+}
+
+// send is smtp.SendMail over a connection that is closed when the context
+// is done, so a hung server doesn't leave the send running
+func (s *SMTP) send(ctx context.Context, auth smtp.Auth, msg []byte) error {
+	host, _, err := net.SplitHostPort(s.Address)
+	if err != nil {
+		return err
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", s.Address)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	err = sendMail(conn, host, auth, s.From, s.To, msg)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	// The connection can time out a moment before the context does
+	var netErr net.Error
+	if deadline, isa := ctx.Deadline(); isa && errors.As(err, &netErr) && netErr.Timeout() && !time.Now().Before(deadline) {
+		return context.DeadlineExceeded
+	}
+
+	return err
+}
+
+func sendMail(conn net.Conn, host string, auth smtp.Auth, from string, to []string, msg []byte) error {
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+
+	if auth != nil {
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+
+	for _, addr := range to {
+		if err := c.Rcpt(addr); err != nil {
+			return err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
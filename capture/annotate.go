@@ -0,0 +1,273 @@
+package capture
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/goburrow/modbus"
+)
+
+// Annotated is an exchange described with the register definition.
+type Annotated struct {
+	Time          time.Time     `json:"time"`
+	Client        string        `json:"client"`
+	Server        string        `json:"server"`
+	TransactionID uint16        `json:"transaction_id"`
+	UnitID        byte          `json:"unit_id"`
+	Function      string        `json:"function"`
+	Address       int           `json:"address,omitempty"`
+	Quantity      int           `json:"quantity,omitempty"`
+	Request       string        `json:"request,omitempty"`
+	Response      string        `json:"response,omitempty"`
+	Exception     string        `json:"exception,omitempty"`
+	Latency       time.Duration `json:"latency,omitempty"`
+	Quirks        []string      `json:"quirks,omitempty"`
+	Model         string        `json:"model,omitempty"`
+	Registers     []Value       `json:"registers,omitempty"`
+}
+
+// Value is one register in an exchange, Value is missing when it wasn't answered.
+type Value struct {
+	Address int         `json:"address"`
+	Name    string      `json:"name"`
+	Value   interface{} `json:"value,omitempty"`
+	Unit    string      `json:"unit,omitempty"`
+	Error   string      `json:"error,omitempty"`
+}
+
+// Annotator names the registers in exchanges, it learns each device's model
+// from reads of device_type_code.
+type Annotator struct {
+	Inverter *sungrow.Inverter
+
+	models map[string]string
+}
+
+// NewAnnotator allocates an Annotator for the register definition.
+func NewAnnotator(inv *sungrow.Inverter) *Annotator {
+	return &Annotator{Inverter: inv, models: map[string]string{}}
+}
+
+// Annotate describes the exchange.
+func (a *Annotator) Annotate(e Exchange) Annotated {
+	an := Annotated{
+		Time:          e.Time,
+		Client:        e.Client.String(),
+		Server:        e.Server.String(),
+		TransactionID: e.TransactionID,
+		UnitID:        e.UnitID,
+		Latency:       e.Latency,
+	}
+
+	for _, q := range e.Quirks {
+		an.Quirks = append(an.Quirks, q.Name)
+	}
+
+	pdu := e.Request
+	if pdu == nil {
+		pdu = e.Response
+	}
+	an.Function = FunctionName(pdu.FunctionCode &^ 0x80)
+
+	if e.Request != nil {
+		an.Request = hex.EncodeToString(append([]byte{e.Request.FunctionCode}, e.Request.Data...))
+	}
+	if e.Response != nil {
+		an.Response = hex.EncodeToString(append([]byte{e.Response.FunctionCode}, e.Response.Data...))
+
+		if e.Response.FunctionCode&0x80 != 0 {
+			code := byte(0)
+			if len(e.Response.Data) > 0 {
+				code = e.Response.Data[0]
+			}
+			an.Exception = ExceptionName(code)
+		}
+	}
+
+	// Without the request there's no telling which registers were read
+	if e.Request == nil || len(e.Request.Data) < 4 {
+		return an
+	}
+
+	device := fmt.Sprintf("%s/%d", an.Server, e.UnitID)
+	address := binary.BigEndian.Uint16(e.Request.Data)
+	an.Address = int(address) + 1
+
+	var holding bool
+	var data []byte
+	switch e.Request.FunctionCode {
+	case modbus.FuncCodeReadInputRegisters, modbus.FuncCodeReadHoldingRegisters:
+		holding = e.Request.FunctionCode == modbus.FuncCodeReadHoldingRegisters
+		an.Quantity = int(binary.BigEndian.Uint16(e.Request.Data[2:]))
+
+		if an.Exception == "" && e.Response != nil && len(e.Response.Data) > 0 {
+			data = e.Response.Data[1:]
+		}
+	case modbus.FuncCodeWriteSingleRegister:
+		holding, an.Quantity = true, 1
+		data = e.Request.Data[2:4]
+	case modbus.FuncCodeWriteMultipleRegisters:
+		if len(e.Request.Data) < 5 {
+			return an
+		}
+		holding = true
+		an.Quantity = int(binary.BigEndian.Uint16(e.Request.Data[2:]))
+		data = e.Request.Data[5:]
+	default:
+		return an
+	}
+
+	an.Registers = a.decode(device, holding, address, an.Quantity, data)
+	an.Model = a.models[device]
+
+	return an
+}
+
+func (a *Annotator) decode(device string, holding bool, address uint16, quantity int, data []byte) []Value {
+	answered := data != nil
+	if !answered {
+		// Still worth knowing what was asked for
+		data = make([]byte, quantity*2)
+	}
+
+	model := a.models[device]
+	decoded := a.Inverter.Decode(holding, address, data, model)
+
+	if model == "" && answered {
+		for _, r := range decoded {
+			if r.Name == "device_type_code" {
+				if m, isa := r.Value.(string); isa {
+					a.models[device] = m
+					decoded = a.Inverter.Decode(holding, address, data, m)
+				}
+				break
+			}
+		}
+	}
+
+	var values []Value
+	seen := map[int]bool{}
+	for _, r := range decoded {
+		// Without the model every variant of a register turns up
+		if seen[r.Address] {
+			continue
+		}
+		seen[r.Address] = true
+
+		v := Value{Address: r.Address, Name: r.Name}
+		if r.Unit != nil {
+			v.Unit = *r.Unit
+		}
+
+		if answered {
+			v.Value = r.Value
+			if r.Err != nil {
+				v.Error = r.Err.Error()
+			}
+		}
+
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// String is a one line summary, followed by a line per register.
+func (an Annotated) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s %s -> %s #%d unit %d %s", an.Time.Format("2006-01-02T15:04:05.000000Z07:00"), an.Client, an.Server, an.TransactionID, an.UnitID, an.Function)
+	if an.Quantity > 0 {
+		fmt.Fprintf(&sb, " %d x%d", an.Address, an.Quantity)
+	}
+
+	switch {
+	case an.Exception != "":
+		fmt.Fprintf(&sb, ": %s", an.Exception)
+	case an.Response == "":
+		sb.WriteString(": no response")
+	}
+
+	if an.Latency > 0 {
+		fmt.Fprintf(&sb, " (%s)", an.Latency)
+	}
+
+	if len(an.Quirks) > 0 {
+		fmt.Fprintf(&sb, " [%s]", strings.Join(an.Quirks, ", "))
+	}
+
+	for _, v := range an.Registers {
+		fmt.Fprintf(&sb, "\n    %d %s", v.Address, v.Name)
+		switch {
+		case v.Error != "":
+			fmt.Fprintf(&sb, " error: %s", v.Error)
+		case v.Value != nil:
+			fmt.Fprintf(&sb, " = %v", v.Value)
+			if v.Unit != "" {
+				fmt.Fprintf(&sb, " %s", v.Unit)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// FunctionName describes a function code.
+func FunctionName(code byte) string {
+	switch code {
+	case modbus.FuncCodeReadCoils:
+		return "read coils"
+	case modbus.FuncCodeReadDiscreteInputs:
+		return "read discrete inputs"
+	case modbus.FuncCodeReadHoldingRegisters:
+		return "read holding"
+	case modbus.FuncCodeReadInputRegisters:
+		return "read input"
+	case modbus.FuncCodeWriteSingleCoil:
+		return "write coil"
+	case modbus.FuncCodeWriteSingleRegister:
+		return "write holding"
+	case modbus.FuncCodeWriteMultipleCoils:
+		return "write coils"
+	case modbus.FuncCodeWriteMultipleRegisters:
+		return "write holdings"
+	case modbus.FuncCodeReadWriteMultipleRegisters:
+		return "read/write holdings"
+	case modbus.FuncCodeMaskWriteRegister:
+		return "mask write holding"
+	case modbus.FuncCodeReadFIFOQueue:
+		return "read fifo"
+	}
+
+	return fmt.Sprintf("function %d", code)
+}
+
+// ExceptionName describes an exception code.
+func ExceptionName(code byte) string {
+	switch code {
+	case modbus.ExceptionCodeIllegalFunction:
+		return "illegal function"
+	case modbus.ExceptionCodeIllegalDataAddress:
+		return "illegal data address"
+	case modbus.ExceptionCodeIllegalDataValue:
+		return "illegal data value"
+	case modbus.ExceptionCodeServerDeviceFailure:
+		return "server device failure"
+	case modbus.ExceptionCodeAcknowledge:
+		return "acknowledge"
+	case modbus.ExceptionCodeServerDeviceBusy:
+		return "server device busy"
+	case modbus.ExceptionCodeMemoryParityError:
+		return "memory parity error"
+	case modbus.ExceptionCodeGatewayPathUnavailable:
+		return "gateway path unavailable"
+	case modbus.ExceptionCodeGatewayTargetDeviceFailedToRespond:
+		return "gateway target device failed to respond"
+	}
+
+	return fmt.Sprintf("exception %d", code)
+}
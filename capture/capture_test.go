@@ -0,0 +1,232 @@
+package capture_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/capture"
+	"github.com/freman/sungrow/transport"
+	"github.com/stretchr/testify/require"
+)
+
+const registers = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0x147: "SG5KTL-MT"
+    - address: 5001
+      name: "nominal_active_power"
+      unit: "kW"
+      scale: 0.1
+  holding:
+    - address: 13000
+      name: "ems_mode_selection"
+      values:
+        0: "self consumption"
+        2: "forced"
+`
+
+var (
+	client = []byte{192, 168, 1, 50}
+	server = []byte{192, 168, 1, 20}
+	epoch  = time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+)
+
+type packet struct {
+	fromClient bool
+	seq        uint32
+	flags      byte
+	payload    []byte
+}
+
+// ethernet builds an ethernet, ipv4 and tcp frame
+func ethernet(p packet) []byte {
+	src, dst, sport, dport := client, server, uint16(50123), uint16(502)
+	if !p.fromClient {
+		src, dst, sport, dport = server, client, 502, 50123
+	}
+
+	tcp := make([]byte, 20)
+	binary.BigEndian.PutUint16(tcp, sport)
+	binary.BigEndian.PutUint16(tcp[2:], dport)
+	binary.BigEndian.PutUint32(tcp[4:], p.seq)
+	tcp[12] = 5 << 4
+	tcp[13] = p.flags | 0x10
+
+	ip := make([]byte, 20)
+	ip[0] = 0x45
+	binary.BigEndian.PutUint16(ip[2:], uint16(20+len(tcp)+len(p.payload)))
+	ip[8] = 64
+	ip[9] = 6
+	copy(ip[12:], src)
+	copy(ip[16:], dst)
+
+	frame := append(make([]byte, 12), 0x08, 0x00)
+	frame = append(frame, ip...)
+	frame = append(frame, tcp...)
+	return append(frame, p.payload...)
+}
+
+func pcap(packets []packet) []byte {
+	var buf bytes.Buffer
+
+	header := make([]byte, 24)
+	binary.LittleEndian.PutUint32(header, 0xa1b2c3d4)
+	binary.LittleEndian.PutUint16(header[4:], 2)
+	binary.LittleEndian.PutUint16(header[6:], 4)
+	binary.LittleEndian.PutUint32(header[16:], 65535)
+	binary.LittleEndian.PutUint32(header[20:], capture.LinkTypeEthernet)
+	buf.Write(header)
+
+	for i, p := range packets {
+		data := ethernet(p)
+		t := epoch.Add(time.Duration(i) * time.Millisecond)
+
+		rec := make([]byte, 16)
+		binary.LittleEndian.PutUint32(rec, uint32(t.Unix()))
+		binary.LittleEndian.PutUint32(rec[4:], uint32(t.Nanosecond()/1000))
+		binary.LittleEndian.PutUint32(rec[8:], uint32(len(data)))
+		binary.LittleEndian.PutUint32(rec[12:], uint32(len(data)))
+		buf.Write(rec)
+		buf.Write(data)
+	}
+
+	return buf.Bytes()
+}
+
+func block(blockType uint32, body []byte) []byte {
+	for len(body)%4 != 0 {
+		body = append(body, 0)
+	}
+
+	b := make([]byte, 8, 12+len(body))
+	binary.BigEndian.PutUint32(b, blockType)
+	binary.BigEndian.PutUint32(b[4:], uint32(12+len(body)))
+	b = append(b, body...)
+	b = append(b, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(b[len(b)-4:], uint32(12+len(body)))
+	return b
+}
+
+// pcapng writes big endian with nanosecond timestamps
+func pcapng(packets []packet) []byte {
+	var buf bytes.Buffer
+
+	shb := []byte{0x1a, 0x2b, 0x3c, 0x4d, 0, 1, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	buf.Write(block(0x0a0d0d0a, shb))
+
+	idb := []byte{0, capture.LinkTypeEthernet, 0, 0, 0, 0, 0xff, 0xff}
+	idb = append(idb, 0, 9, 0, 1, 9, 0, 0, 0, 0, 0, 0, 0)
+	buf.Write(block(1, idb))
+
+	for i, p := range packets {
+		data := ethernet(p)
+		ts := uint64(epoch.Add(time.Duration(i) * time.Millisecond).UnixNano())
+
+		epb := make([]byte, 20)
+		binary.BigEndian.PutUint32(epb[4:], uint32(ts>>32))
+		binary.BigEndian.PutUint32(epb[8:], uint32(ts))
+		binary.BigEndian.PutUint32(epb[12:], uint32(len(data)))
+		binary.BigEndian.PutUint32(epb[16:], uint32(len(data)))
+		buf.Write(block(6, append(epb, data...)))
+	}
+
+	return buf.Bytes()
+}
+
+func mbap(tid uint16, length uint16, pdu ...byte) []byte {
+	b := make([]byte, 7)
+	binary.BigEndian.PutUint16(b, tid)
+	binary.BigEndian.PutUint16(b[4:], length)
+	b[6] = 1
+	return append(b, pdu...)
+}
+
+func conversation() []packet {
+	readReq := mbap(1, 6, 0x04, 0x13, 0x87, 0x00, 0x02)
+	readResp := mbap(1, 7, 0x04, 0x04, 0x01, 0x47, 0x00, 0x32)
+	holdReq := mbap(2, 6, 0x03, 0x32, 0xc7, 0x00, 0x01)
+	// Short exception, and raised as 0x84 for a holding read
+	holdResp := mbap(2, 2, 0x84, 0x02)
+	writeReq := mbap(3, 6, 0x06, 0x32, 0xc7, 0x00, 0x02)
+
+	return []packet{
+		{fromClient: true, seq: 999, flags: 0x02},
+		{fromClient: false, seq: 4999, flags: 0x02},
+		// The request split in two, with the first part retransmitted
+		{fromClient: true, seq: 1000, payload: readReq[:5]},
+		{fromClient: true, seq: 1000, payload: readReq[:5]},
+		{fromClient: true, seq: 1005, payload: readReq[5:]},
+		// The response out of order
+		{fromClient: false, seq: 5010, payload: readResp[10:]},
+		{fromClient: false, seq: 5000, payload: readResp[:10]},
+		{fromClient: true, seq: 1012, payload: holdReq},
+		{fromClient: false, seq: 5013, payload: holdResp},
+		// Never answered
+		{fromClient: true, seq: 1024, payload: writeReq},
+	}
+}
+
+func annotate(t *testing.T, exchanges []capture.Exchange) []capture.Annotated {
+	var inv sungrow.Inverter
+	require.NoError(t, inv.Define(strings.NewReader(registers)))
+
+	a := capture.NewAnnotator(&inv)
+
+	var annotated []capture.Annotated
+	for _, e := range exchanges {
+		annotated = append(annotated, a.Annotate(e))
+	}
+	return annotated
+}
+
+func TestReadPcap(t *testing.T) {
+	requires := require.New(t)
+
+	exchanges, err := capture.ReadAll(bytes.NewReader(pcap(conversation())))
+	requires.NoError(err)
+	requires.Len(exchanges, 3)
+
+	requires.Equal("192.168.1.50:50123", exchanges[0].Client.String())
+	requires.Equal("192.168.1.20:502", exchanges[0].Server.String())
+	requires.Equal(2*time.Millisecond, exchanges[0].Latency)
+	requires.Equal(epoch.Add(4*time.Millisecond), exchanges[0].Time)
+
+	requires.Equal([]transport.Quirk{transport.QuirkShortException, transport.QuirkExceptionFunction}, exchanges[1].Quirks)
+	requires.Nil(exchanges[2].Response)
+
+	an := annotate(t, exchanges)
+
+	requires.Equal("read input", an[0].Function)
+	requires.Equal(5000, an[0].Address)
+	requires.Equal("SG5KTL-MT", an[0].Model)
+	requires.Equal([]capture.Value{
+		{Address: 5000, Name: "device_type_code", Value: "SG5KTL-MT"},
+		{Address: 5001, Name: "nominal_active_power", Value: 5.0, Unit: "kW"},
+	}, an[0].Registers)
+
+	requires.Equal("illegal data address", an[1].Exception)
+	requires.Equal([]capture.Value{{Address: 13000, Name: "ems_mode_selection"}}, an[1].Registers)
+
+	requires.Equal("write holding", an[2].Function)
+	requires.Equal([]capture.Value{{Address: 13000, Name: "ems_mode_selection", Value: "forced"}}, an[2].Registers)
+	requires.Contains(an[2].String(), "no response")
+}
+
+func TestReadPcapng(t *testing.T) {
+	requires := require.New(t)
+
+	exchanges, err := capture.ReadAll(bytes.NewReader(pcapng(conversation())))
+	requires.NoError(err)
+	requires.Len(exchanges, 3)
+	requires.Equal(epoch.Add(4*time.Millisecond), exchanges[0].Time)
+	requires.Equal(2*time.Millisecond, exchanges[0].Latency)
+
+	_, err = capture.ReadAll(strings.NewReader("not a capture at all"))
+	requires.ErrorIs(err, capture.ErrFormat)
+}
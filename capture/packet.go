@@ -0,0 +1,171 @@
+package capture
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+)
+
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86dd
+	etherTypeVLAN = 0x8100
+	etherTypeQinQ = 0x88a8
+
+	protocolTCP = 6
+
+	tcpFin = 0x01
+	tcpSyn = 0x02
+	tcpRst = 0x04
+)
+
+var errNotTCP = errors.New("not a tcp packet")
+
+// Segment is the TCP part of a packet.
+type Segment struct {
+	Src, Dst Endpoint
+	Seq      uint32
+	Flags    byte
+	Payload  []byte
+}
+
+// Endpoint is an address and port.
+type Endpoint struct {
+	IP   string
+	Port uint16
+}
+
+func (e Endpoint) String() string {
+	return net.JoinHostPort(e.IP, strconv.Itoa(int(e.Port)))
+}
+
+// DecodeTCP digs the TCP segment out of a packet.
+func DecodeTCP(p Packet) (Segment, error) {
+	data := p.Data
+
+	var etherType uint16
+	switch p.LinkType {
+	case LinkTypeEthernet:
+		if len(data) < 14 {
+			return Segment{}, errors.New("truncated ethernet header")
+		}
+		etherType, data = binary.BigEndian.Uint16(data[12:]), data[14:]
+
+		for etherType == etherTypeVLAN || etherType == etherTypeQinQ {
+			if len(data) < 4 {
+				return Segment{}, errors.New("truncated vlan header")
+			}
+			etherType, data = binary.BigEndian.Uint16(data[2:]), data[4:]
+		}
+	case LinkTypeLinuxSLL:
+		if len(data) < 16 {
+			return Segment{}, errors.New("truncated linux cooked header")
+		}
+		etherType, data = binary.BigEndian.Uint16(data[14:]), data[16:]
+	case LinkTypeSLL2:
+		if len(data) < 20 {
+			return Segment{}, errors.New("truncated linux cooked header")
+		}
+		etherType, data = binary.BigEndian.Uint16(data), data[20:]
+	case LinkTypeNull, LinkTypeLoop:
+		if len(data) < 4 {
+			return Segment{}, errors.New("truncated loopback header")
+		}
+		// The address family is in host byte order, which isn't recorded
+		family := binary.LittleEndian.Uint32(data)
+		if family > 0xffff {
+			family = binary.BigEndian.Uint32(data)
+		}
+		etherType, data = etherTypeIPv4, data[4:]
+		if family != 2 {
+			etherType = etherTypeIPv6
+		}
+	case LinkTypeRaw, LinkTypeIPv4, LinkTypeIPv6:
+		if len(data) < 1 {
+			return Segment{}, errors.New("empty packet")
+		}
+		etherType = etherTypeIPv4
+		if data[0]>>4 == 6 {
+			etherType = etherTypeIPv6
+		}
+	default:
+		return Segment{}, fmt.Errorf("unsupported link type %d", p.LinkType)
+	}
+
+	var s Segment
+	switch etherType {
+	case etherTypeIPv4:
+		if len(data) < 20 || data[0]>>4 != 4 {
+			return Segment{}, errors.New("truncated ipv4 header")
+		}
+
+		ihl := int(data[0]&0x0f) * 4
+		total := int(binary.BigEndian.Uint16(data[2:]))
+		if ihl < 20 || total < ihl || len(data) < ihl {
+			return Segment{}, errors.New("malformed ipv4 header")
+		}
+		if data[9] != protocolTCP {
+			return Segment{}, errNotTCP
+		}
+		// Fragments aren't reassembled, Modbus frames are far too small to need it
+		if binary.BigEndian.Uint16(data[6:])&0x1fff != 0 {
+			return Segment{}, errNotTCP
+		}
+
+		s.Src.IP = net.IP(data[12:16]).String()
+		s.Dst.IP = net.IP(data[16:20]).String()
+
+		// Ethernet pads short frames
+		if total < len(data) {
+			data = data[:total]
+		}
+		data = data[ihl:]
+	case etherTypeIPv6:
+		if len(data) < 40 {
+			return Segment{}, errors.New("truncated ipv6 header")
+		}
+		if data[6] != protocolTCP {
+			return Segment{}, errNotTCP
+		}
+
+		s.Src.IP = net.IP(data[8:24]).String()
+		s.Dst.IP = net.IP(data[24:40]).String()
+
+		if total := 40 + int(binary.BigEndian.Uint16(data[4:])); total < len(data) {
+			data = data[:total]
+		}
+		data = data[40:]
+	default:
+		return Segment{}, errNotTCP
+	}
+
+	if len(data) < 20 {
+		return Segment{}, errors.New("truncated tcp header")
+	}
+
+	offset := int(data[12]>>4) * 4
+	if offset < 20 || offset > len(data) {
+		return Segment{}, errors.New("malformed tcp header")
+	}
+
+	s.Src.Port = binary.BigEndian.Uint16(data)
+	s.Dst.Port = binary.BigEndian.Uint16(data[2:])
+	s.Seq = binary.BigEndian.Uint32(data[4:])
+	s.Flags = data[13]
+	s.Payload = data[offset:]
+
+	return s, nil
+}
+
+// EndpointOf converts a network address.
+func EndpointOf(addr net.Addr) Endpoint {
+	if tcp, isa := addr.(*net.TCPAddr); isa {
+		return Endpoint{IP: tcp.IP.String(), Port: uint16(tcp.Port)}
+	}
+
+	host, port, _ := net.SplitHostPort(addr.String())
+	p, _ := strconv.Atoi(port)
+	return Endpoint{IP: host, Port: uint16(p)}
+}
@@ -0,0 +1,275 @@
+// Package capture reads Modbus TCP exchanges out of pcap and pcapng files.
+package capture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Link types, see https://www.tcpdump.org/linktypes.html
+const (
+	LinkTypeNull     = 0
+	LinkTypeEthernet = 1
+	LinkTypeRaw      = 101
+	LinkTypeLoop     = 108
+	LinkTypeLinuxSLL = 113
+	LinkTypeIPv4     = 228
+	LinkTypeIPv6     = 229
+	LinkTypeSLL2     = 276
+)
+
+const (
+	pcapMagic      = 0xa1b2c3d4
+	pcapMagicNanos = 0xa1b23c4d
+	pcapngMagic    = 0x0a0d0d0a
+	pcapngBOM      = 0x1a2b3c4d
+
+	pcapngInterface       = 0x00000001
+	pcapngSimplePacket    = 0x00000003
+	pcapngEnhancedPacket  = 0x00000006
+	pcapngOptionEnd       = 0
+	pcapngOptionTSResol   = 9
+	maxBlockLength        = 16 << 20
+	defaultTSResolution   = time.Microsecond
+	pcapngEnhancedHeader  = 20
+	pcapngInterfaceHeader = 8
+)
+
+// ErrFormat is returned for files that are neither pcap nor pcapng.
+var ErrFormat = errors.New("not a pcap or pcapng file")
+
+// Packet is one captured frame.
+type Packet struct {
+	Time     time.Time
+	LinkType int
+	Data     []byte
+}
+
+// Reader reads packets from a pcap or pcapng file.
+type Reader struct {
+	r     *bufio.Reader
+	order binary.ByteOrder
+	ng    bool
+
+	// pcap
+	linkType int
+	nanos    bool
+
+	// pcapng
+	interfaces []iface
+}
+
+type iface struct {
+	linkType   int
+	resolution time.Duration
+}
+
+// NewReader works out the format from the file header.
+func NewReader(r io.Reader) (*Reader, error) {
+	pr := &Reader{r: bufio.NewReader(r)}
+
+	magic, err := pr.r.Peek(4)
+	if err != nil {
+		return nil, ErrFormat
+	}
+
+	if binary.LittleEndian.Uint32(magic) == pcapngMagic {
+		pr.ng = true
+		return pr, nil
+	}
+
+	header := make([]byte, 24)
+	if _, err := io.ReadFull(pr.r, header); err != nil {
+		return nil, ErrFormat
+	}
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		switch order.Uint32(header) {
+		case pcapMagic:
+			pr.order = order
+		case pcapMagicNanos:
+			pr.order, pr.nanos = order, true
+		default:
+			continue
+		}
+
+		pr.linkType = int(pr.order.Uint32(header[20:]) & 0x0fffffff)
+		return pr, nil
+	}
+
+	return nil, ErrFormat
+}
+
+// Next returns the next packet, or io.EOF at the end of the file.
+func (pr *Reader) Next() (Packet, error) {
+	if pr.ng {
+		return pr.nextBlock()
+	}
+
+	header := make([]byte, 16)
+	if _, err := io.ReadFull(pr.r, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return Packet{}, fmt.Errorf("truncated packet header: %w", err)
+		}
+		return Packet{}, err
+	}
+
+	sec := pr.order.Uint32(header)
+	frac := pr.order.Uint32(header[4:])
+	caplen := pr.order.Uint32(header[8:])
+	if caplen > maxBlockLength {
+		return Packet{}, fmt.Errorf("packet of %d bytes is too long", caplen)
+	}
+
+	data := make([]byte, caplen)
+	if _, err := io.ReadFull(pr.r, data); err != nil {
+		return Packet{}, fmt.Errorf("truncated packet: %w", err)
+	}
+
+	nsec := int64(frac) * 1000
+	if pr.nanos {
+		nsec = int64(frac)
+	}
+
+	return Packet{Time: time.Unix(int64(sec), nsec).UTC(), LinkType: pr.linkType, Data: data}, nil
+}
+
+func (pr *Reader) nextBlock() (Packet, error) {
+	for {
+		header := make([]byte, 8)
+		if _, err := io.ReadFull(pr.r, header); err != nil {
+			if err == io.ErrUnexpectedEOF {
+				return Packet{}, fmt.Errorf("truncated block header: %w", err)
+			}
+			return Packet{}, err
+		}
+
+		blockType := binary.LittleEndian.Uint32(header)
+
+		// The section header says which way round everything else is
+		if blockType == pcapngMagic {
+			bom := make([]byte, 4)
+			if _, err := io.ReadFull(pr.r, bom); err != nil {
+				return Packet{}, fmt.Errorf("truncated section header: %w", err)
+			}
+
+			if binary.LittleEndian.Uint32(bom) == pcapngBOM {
+				pr.order = binary.LittleEndian
+			} else if binary.BigEndian.Uint32(bom) == pcapngBOM {
+				pr.order = binary.BigEndian
+			} else {
+				return Packet{}, ErrFormat
+			}
+
+			pr.interfaces = nil
+			length := pr.order.Uint32(header[4:])
+			if length < 12 || length > maxBlockLength {
+				return Packet{}, fmt.Errorf("section header of %d bytes", length)
+			}
+			if _, err := pr.r.Discard(int(length) - 12); err != nil {
+				return Packet{}, fmt.Errorf("truncated section header: %w", err)
+			}
+			continue
+		}
+
+		if pr.order == nil {
+			return Packet{}, ErrFormat
+		}
+
+		blockType = pr.order.Uint32(header)
+		length := pr.order.Uint32(header[4:])
+		if length < 12 || length > maxBlockLength || length%4 != 0 {
+			return Packet{}, fmt.Errorf("block of %d bytes", length)
+		}
+
+		body := make([]byte, length-8)
+		if _, err := io.ReadFull(pr.r, body); err != nil {
+			return Packet{}, fmt.Errorf("truncated block: %w", err)
+		}
+		// Drop the trailing copy of the length
+		body = body[:len(body)-4]
+
+		switch blockType {
+		case pcapngInterface:
+			if len(body) < pcapngInterfaceHeader {
+				return Packet{}, errors.New("truncated interface description")
+			}
+			pr.interfaces = append(pr.interfaces, iface{
+				linkType:   int(pr.order.Uint16(body)),
+				resolution: pr.resolution(body[pcapngInterfaceHeader:]),
+			})
+
+		case pcapngEnhancedPacket:
+			if len(body) < pcapngEnhancedHeader {
+				return Packet{}, errors.New("truncated enhanced packet")
+			}
+
+			id := int(pr.order.Uint32(body))
+			if id >= len(pr.interfaces) {
+				return Packet{}, fmt.Errorf("packet on undescribed interface %d", id)
+			}
+
+			ts := uint64(pr.order.Uint32(body[4:]))<<32 | uint64(pr.order.Uint32(body[8:]))
+			caplen := int(pr.order.Uint32(body[12:]))
+			if pcapngEnhancedHeader+caplen > len(body) {
+				return Packet{}, errors.New("truncated enhanced packet")
+			}
+
+			res := pr.interfaces[id].resolution
+			t := time.Unix(0, 0).Add(time.Duration(ts/uint64(time.Second/res))*time.Second +
+				time.Duration(ts%uint64(time.Second/res))*res)
+
+			return Packet{
+				Time:     t.UTC(),
+				LinkType: pr.interfaces[id].linkType,
+				Data:     body[pcapngEnhancedHeader : pcapngEnhancedHeader+caplen],
+			}, nil
+
+		case pcapngSimplePacket:
+			if len(pr.interfaces) == 0 || len(body) < 4 {
+				return Packet{}, errors.New("simple packet without an interface")
+			}
+
+			caplen := int(pr.order.Uint32(body))
+			if 4+caplen > len(body) {
+				caplen = len(body) - 4
+			}
+
+			return Packet{LinkType: pr.interfaces[0].linkType, Data: body[4 : 4+caplen]}, nil
+		}
+	}
+}
+
+// resolution reads if_tsresol from the interface options.
+func (pr *Reader) resolution(options []byte) time.Duration {
+	for len(options) >= 4 {
+		code := pr.order.Uint16(options)
+		length := int(pr.order.Uint16(options[2:]))
+		if code == pcapngOptionEnd || 4+length > len(options) {
+			break
+		}
+
+		if code == pcapngOptionTSResol && length >= 1 {
+			v := options[4]
+			res := time.Second
+			if v&0x80 == 0 {
+				for i := byte(0); i < v && res > 1; i++ {
+					res /= 10
+				}
+			} else {
+				for i := byte(0); i < v&0x7f && res > 1; i++ {
+					res /= 2
+				}
+			}
+			return res
+		}
+
+		options = options[4+(length+3)/4*4:]
+	}
+
+	return defaultTSResolution
+}
@@ -0,0 +1,403 @@
+package capture
+
+import (
+	"encoding/binary"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/freman/sungrow/proxy"
+	"github.com/freman/sungrow/transport"
+	"github.com/goburrow/modbus"
+)
+
+const (
+	mbapHeaderSize = 7
+	mbapMaxLength  = 254
+
+	// Out of order data held per direction before giving up on the gap
+	maxPending = 64 << 10
+)
+
+// DefaultPort is where Modbus TCP servers listen.
+const DefaultPort = 502
+
+// Exchange is a request and its response, either may be missing when the
+// capture started or stopped in between.
+type Exchange struct {
+	Time          time.Time
+	Client        Endpoint
+	Server        Endpoint
+	TransactionID uint16
+	UnitID        byte
+	Request       *modbus.ProtocolDataUnit
+	Response      *modbus.ProtocolDataUnit
+	// Time between the request and the response
+	Latency time.Duration
+	// Ways the response strays from the specification
+	Quirks []transport.Quirk
+}
+
+// Decoder reassembles Modbus TCP conversations out of packets.
+type Decoder struct {
+	// Ports Modbus servers listen on
+	Ports map[uint16]bool
+
+	streams  map[flow]*stream
+	requests map[conversation]map[uint16]frame
+}
+
+type flow struct {
+	src, dst Endpoint
+}
+
+type conversation struct {
+	client, server Endpoint
+}
+
+type frame struct {
+	time   time.Time
+	header []byte
+	pdu    *modbus.ProtocolDataUnit
+	quirks []transport.Quirk
+}
+
+type stream struct {
+	started bool
+	next    uint32
+	buf     []byte
+	pending map[uint32][]byte
+	size    int
+}
+
+// NewDecoder allocates a Decoder for servers on the given ports, DefaultPort
+// when none are given.
+func NewDecoder(ports ...uint16) *Decoder {
+	if len(ports) == 0 {
+		ports = []uint16{DefaultPort}
+	}
+
+	d := &Decoder{
+		Ports:    map[uint16]bool{},
+		streams:  map[flow]*stream{},
+		requests: map[conversation]map[uint16]frame{},
+	}
+
+	for _, p := range ports {
+		d.Ports[p] = true
+	}
+
+	return d
+}
+
+// Add feeds a packet to the decoder, returning the exchanges it completed.
+func (d *Decoder) Add(p Packet) []Exchange {
+	seg, err := DecodeTCP(p)
+	if err != nil {
+		return nil
+	}
+
+	var c conversation
+	var fromClient bool
+	switch {
+	case d.Ports[seg.Dst.Port]:
+		c, fromClient = conversation{seg.Src, seg.Dst}, true
+	case d.Ports[seg.Src.Port]:
+		c = conversation{seg.Dst, seg.Src}
+	default:
+		return nil
+	}
+
+	s := d.stream(flow{seg.Src, seg.Dst})
+
+	if seg.Flags&(tcpSyn|tcpRst) != 0 {
+		*s = stream{pending: map[uint32][]byte{}}
+		if seg.Flags&tcpSyn != 0 {
+			s.started, s.next = true, seg.Seq+1
+		}
+		return nil
+	}
+
+	s.add(seg.Seq, seg.Payload)
+
+	exchanges := d.collect(c, fromClient, s.frames(p.Time))
+
+	if seg.Flags&tcpFin != 0 && !fromClient {
+		exchanges = append(exchanges, d.unanswered(c)...)
+	}
+
+	return exchanges
+}
+
+// Feed adds data read from one side of a connection, for when the bytes are
+// already in order, returning the exchanges it completed.
+func (d *Decoder) Feed(client, server Endpoint, fromClient bool, t time.Time, data []byte) []Exchange {
+	f := flow{server, client}
+	if fromClient {
+		f = flow{client, server}
+	}
+
+	s := d.stream(f)
+	s.buf = append(s.buf, data...)
+
+	return d.collect(conversation{client, server}, fromClient, s.frames(t))
+}
+
+// Close forgets a connection, returning the requests it never answered.
+func (d *Decoder) Close(client, server Endpoint) []Exchange {
+	delete(d.streams, flow{client, server})
+	delete(d.streams, flow{server, client})
+
+	return d.unanswered(conversation{client, server})
+}
+
+func (d *Decoder) stream(f flow) *stream {
+	s := d.streams[f]
+	if s == nil {
+		s = &stream{pending: map[uint32][]byte{}}
+		d.streams[f] = s
+	}
+	return s
+}
+
+// collect pairs up the frames of a conversation.
+func (d *Decoder) collect(c conversation, fromClient bool, frames []frame) []Exchange {
+	var exchanges []Exchange
+	for _, fr := range frames {
+		if fromClient {
+			if d.requests[c] == nil {
+				d.requests[c] = map[uint16]frame{}
+			}
+
+			tid := binary.BigEndian.Uint16(fr.header)
+			if old, isa := d.requests[c][tid]; isa {
+				// Never answered and the id has come around again
+				exchanges = append(exchanges, exchange(c, &old, nil))
+			}
+			d.requests[c][tid] = fr
+			continue
+		}
+
+		tid := binary.BigEndian.Uint16(fr.header)
+		req, isa := d.requests[c][tid]
+		if !isa {
+			exchanges = append(exchanges, exchange(c, nil, &fr))
+			continue
+		}
+
+		delete(d.requests[c], tid)
+		exchanges = append(exchanges, exchange(c, &req, &fr))
+	}
+
+	return exchanges
+}
+
+// Flush returns the requests that were never answered.
+func (d *Decoder) Flush() []Exchange {
+	var exchanges []Exchange
+	for c := range d.requests {
+		exchanges = append(exchanges, d.unanswered(c)...)
+	}
+
+	sort.SliceStable(exchanges, func(i, j int) bool {
+		return exchanges[i].Time.Before(exchanges[j].Time)
+	})
+
+	return exchanges
+}
+
+func (d *Decoder) unanswered(c conversation) []Exchange {
+	var exchanges []Exchange
+	for _, req := range d.requests[c] {
+		req := req
+		exchanges = append(exchanges, exchange(c, &req, nil))
+	}
+	delete(d.requests, c)
+
+	sort.SliceStable(exchanges, func(i, j int) bool {
+		return exchanges[i].Time.Before(exchanges[j].Time)
+	})
+
+	return exchanges
+}
+
+func exchange(c conversation, req, resp *frame) Exchange {
+	e := Exchange{Client: c.client, Server: c.server}
+
+	header := resp
+	if req != nil {
+		header = req
+		e.Time = req.time
+		e.Request = req.pdu
+	}
+
+	e.TransactionID = binary.BigEndian.Uint16(header.header)
+	e.UnitID = header.header[6]
+
+	if resp != nil {
+		if req == nil {
+			e.Time = resp.time
+		} else {
+			e.Latency = resp.time.Sub(req.time)
+		}
+		e.Response = resp.pdu
+		e.Quirks = append(e.Quirks, resp.quirks...)
+
+		if req != nil {
+			_, repaired := proxy.Normalise(req.pdu, resp.pdu)
+			for _, q := range repaired {
+				if !hasQuirk(e.Quirks, q) {
+					e.Quirks = append(e.Quirks, q)
+				}
+			}
+		}
+	}
+
+	return e
+}
+
+func hasQuirk(quirks []transport.Quirk, q transport.Quirk) bool {
+	for _, x := range quirks {
+		if x.Name == q.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadAll reads every exchange in a capture.
+func ReadAll(r io.Reader, ports ...uint16) ([]Exchange, error) {
+	pr, err := NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	d := NewDecoder(ports...)
+
+	var exchanges []Exchange
+	for {
+		p, err := pr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return exchanges, err
+		}
+
+		exchanges = append(exchanges, d.Add(p)...)
+	}
+
+	return append(exchanges, d.Flush()...), nil
+}
+
+// add puts a segment's payload in order, dropping what's been seen already.
+func (s *stream) add(seq uint32, payload []byte) {
+	if len(payload) == 0 {
+		return
+	}
+
+	// Picked up part way through the connection
+	if !s.started {
+		s.started, s.next = true, seq
+	}
+
+	if int32(seq-s.next) > 0 {
+		s.pending[seq] = append([]byte(nil), payload...)
+		s.size += len(payload)
+		if s.size <= maxPending {
+			return
+		}
+
+		// The gap is never going to be filled, skip it
+		s.skip()
+	} else {
+		s.append(seq, payload)
+	}
+
+	for {
+		applied := false
+		for seq, payload := range s.pending {
+			if int32(seq-s.next) > 0 {
+				continue
+			}
+
+			delete(s.pending, seq)
+			s.size -= len(payload)
+			s.append(seq, payload)
+			applied = true
+		}
+
+		if !applied {
+			return
+		}
+	}
+}
+
+func (s *stream) append(seq uint32, payload []byte) {
+	overlap := int(int32(s.next - seq))
+	if overlap >= len(payload) {
+		// Retransmitted
+		return
+	}
+
+	s.buf = append(s.buf, payload[overlap:]...)
+	s.next += uint32(len(payload) - overlap)
+}
+
+// skip moves on to the earliest pending data, what's buffered can't be completed.
+func (s *stream) skip() {
+	first := true
+	for seq := range s.pending {
+		if first || int32(seq-s.next) < 0 {
+			s.next, first = seq, false
+		}
+	}
+	s.buf = nil
+}
+
+// frames cuts the complete Modbus frames off the front of the buffer.
+func (s *stream) frames(t time.Time) []frame {
+	var frames []frame
+
+	for len(s.buf) >= mbapHeaderSize+1 {
+		header := s.buf[:mbapHeaderSize]
+		length := int(binary.BigEndian.Uint16(header[4:]))
+
+		// Not a frame boundary, resynchronise a byte at a time
+		if binary.BigEndian.Uint16(header[2:]) != 0 || length < 2 || length > mbapMaxLength {
+			s.buf = s.buf[1:]
+			continue
+		}
+
+		var quirks []transport.Quirk
+		size := mbapHeaderSize - 1 + length
+
+		if transport.IsShortException(header, s.buf[mbapHeaderSize]) {
+			if len(s.buf) == size {
+				// Nothing more came with it, it really is empty
+				quirks = append(quirks, transport.QuirkEmptyException)
+			} else {
+				size++
+				quirks = append(quirks, transport.QuirkShortException)
+			}
+		}
+
+		if len(s.buf) < size {
+			break
+		}
+
+		frames = append(frames, frame{
+			time:   t,
+			header: append([]byte(nil), header...),
+			pdu: &modbus.ProtocolDataUnit{
+				FunctionCode: s.buf[mbapHeaderSize],
+				Data:         append([]byte(nil), s.buf[mbapHeaderSize+1:size]...),
+			},
+			quirks: quirks,
+		})
+
+		s.buf = s.buf[size:]
+	}
+
+	return frames
+}
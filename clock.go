@@ -0,0 +1,141 @@
+package sungrow
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+)
+
+// The system clock holding registers, in address order
+var clockRegisters = []string{
+	"system_clock_year",
+	"system_clock_month",
+	"system_clock_day",
+	"system_clock_hour",
+	"system_clock_minute",
+	"system_clock_second",
+}
+
+// clockAddress returns the address of the first clock register, the clock is
+// read and written as one block so it doesn't tick over half way.
+func (i *Inverter) clockAddress() (int, error) {
+	var first int
+	for idx, name := range clockRegisters {
+		r := i.Holding(name)
+		if r == nil {
+			return 0, fmt.Errorf("no holding register %q for %q", name, i.Model())
+		}
+
+		if idx == 0 {
+			first = r.Address
+		} else if r.Address != first+idx {
+			return 0, fmt.Errorf("clock registers aren't contiguous at %q", name)
+		}
+	}
+
+	return first, nil
+}
+
+// Clock reads the inverter's clock, which keeps wall time in loc.
+func (i *Inverter) Clock(client Modbus, loc *time.Location) (time.Time, error) {
+	address, err := i.clockAddress()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	results, err := client.ReadHoldingRegisters(uint16(address-1), uint16(len(clockRegisters)))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if len(results) != len(clockRegisters)*2 {
+		return time.Time{}, fmt.Errorf("clock read returned %d bytes", len(results))
+	}
+
+	var parts [6]int
+	for idx, name := range clockRegisters {
+		r := i.Holding(name)
+		r.model = i.Model()
+		r.Supported, r.Err = true, nil
+		r.read(bytes.NewReader(results[idx*2 : idx*2+2]))
+
+		v, _ := r.Float()
+		parts[idx] = int(v)
+	}
+
+	if parts[0] < 100 {
+		parts[0] += 2000
+	}
+
+	if parts[1] < 1 || parts[1] > 12 || parts[2] < 1 || parts[2] > 31 || parts[3] > 23 || parts[4] > 59 || parts[5] > 59 {
+		return time.Time{}, fmt.Errorf("inverter clock reads %v, which isn't a time", parts)
+	}
+
+	return time.Date(parts[0], time.Month(parts[1]), parts[2], parts[3], parts[4], parts[5], 0, loc), nil
+}
+
+// ClockDrift returns how far the inverter's clock is ahead of now.
+func (i *Inverter) ClockDrift(client Modbus, loc *time.Location, now func() time.Time) (time.Duration, error) {
+	before := now()
+	clock, err := i.Clock(client, loc)
+	if err != nil {
+		return 0, err
+	}
+	after := now()
+
+	// The clock was read somewhere in between, and only to the second
+	host := before.Add(after.Sub(before) / 2).Truncate(time.Second)
+
+	return clock.Sub(host), nil
+}
+
+// SetClock writes t to the inverter's clock as wall time in loc and reads it back.
+func (i *Inverter) SetClock(client ModbusWriter, t time.Time, loc *time.Location) error {
+	address, err := i.clockAddress()
+	if err != nil {
+		return err
+	}
+
+	t = t.In(loc).Truncate(time.Second)
+	parts := []int{t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second()}
+
+	var raw []byte
+	for idx, name := range clockRegisters {
+		b, err := i.Holding(name).Encode(parts[idx])
+		if err != nil {
+			return err
+		}
+		raw = append(raw, b...)
+	}
+
+	if _, err := client.WriteMultipleRegisters(uint16(address-1), uint16(len(clockRegisters)), raw); err != nil {
+		return fmt.Errorf("failed to set the clock: %w", err)
+	}
+
+	clock, err := i.Clock(client, loc)
+	if err != nil {
+		return fmt.Errorf("failed to read back the clock: %w", err)
+	}
+
+	// Allow for the clock ticking over in between
+	if d := clock.Sub(t); d < 0 || d > 2*time.Second {
+		return fmt.Errorf("%w: clock set to %s reads %s", ErrVerify, t.Format(time.RFC3339), clock.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// SyncClock corrects the inverter's clock when it has drifted more than
+// threshold from now, returning the drift found and whether it was corrected.
+func (i *Inverter) SyncClock(client ModbusWriter, loc *time.Location, threshold time.Duration, now func() time.Time) (time.Duration, bool, error) {
+	drift, err := i.ClockDrift(client, loc, now)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if drift < threshold && drift > -threshold {
+		return drift, false, nil
+	}
+
+	return drift, true, i.SetClock(client, now(), loc)
+}
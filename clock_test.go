@@ -0,0 +1,72 @@
+package sungrow_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/internal/modbustest"
+	"github.com/stretchr/testify/require"
+)
+
+const clockRegisters = `registers:
+  holding:
+    - address: 5000
+      name: "system_clock_year"
+    - address: 5001
+      name: "system_clock_month"
+    - address: 5002
+      name: "system_clock_day"
+    - address: 5003
+      name: "system_clock_hour"
+    - address: 5004
+      name: "system_clock_minute"
+    - address: 5005
+      name: "system_clock_second"
+`
+
+func TestClock(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(clockRegisters)))
+
+	brisbane := time.FixedZone("AEST", 10*60*60)
+
+	client := &modbustest.Device{
+		Holding: map[uint16]uint16{4999: 2023, 5000: 11, 5001: 4, 5002: 13, 5003: 37, 5004: 5},
+	}
+
+	clock, err := inv.Clock(client, brisbane)
+	requires.NoError(err)
+	requires.Equal(time.Date(2023, 11, 4, 3, 37, 5, 0, time.UTC), clock.UTC())
+
+	// Host is three minutes ahead
+	host := time.Date(2023, 11, 4, 3, 40, 5, 400, time.UTC)
+	now := func() time.Time { return host }
+
+	drift, err := inv.ClockDrift(client, brisbane, now)
+	requires.NoError(err)
+	requires.Equal(-3*time.Minute, drift)
+
+	drift, corrected, err := inv.SyncClock(client, brisbane, 5*time.Minute, now)
+	requires.NoError(err)
+	requires.False(corrected)
+	requires.Equal(-3*time.Minute, drift)
+	requires.Equal(0, client.Writes)
+
+	_, corrected, err = inv.SyncClock(client, brisbane, time.Minute, now)
+	requires.NoError(err)
+	requires.True(corrected)
+	requires.Equal(1, client.Writes)
+	requires.Equal(map[uint16]uint16{4999: 2023, 5000: 11, 5001: 4, 5002: 13, 5003: 40, 5004: 5}, client.Holding)
+
+	// The clock didn't take
+	client.Ignored = map[uint16]bool{5002: true}
+	requires.ErrorIs(inv.SetClock(client, host.Add(time.Hour), brisbane), sungrow.ErrVerify)
+
+	client.Holding[5000] = 13
+	_, err = inv.Clock(client, brisbane)
+	requires.Error(err)
+}
@@ -8,15 +8,44 @@ import (
 	"text/template"
 
 	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/convert"
 )
 
 func main() {
 	model := flag.String("model", "SH10RT", "Name of the register")
-	registers := flag.String("regs", "sungrow.yml", "Register definition file")
+	registers := flag.String("regs", "", "Register definition file layered over the built in maps")
 	format := flag.String("format", "{{.Address}} {{.Name}}", "Render template")
+	export := flag.String("export", "", "Export the model's registers as ha (Home Assistant modbus YAML) or json (for Node-RED, EVCC and the like) rather than rendering the template")
+	host := flag.String("host", "192.168.1.20", "Inverter address for the Home Assistant export")
+	slave := flag.Int("slave", 1, "Modbus unit id for the Home Assistant export")
+	prefix := flag.String("prefix", "", "Put in front of the sensor names of the Home Assistant export, eg \"Sungrow \"")
 
 	flag.Parse()
 
+	var inv sungrow.Inverter
+	if err := inv.DefineDefault(*registers); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	switch *export {
+	case "":
+	case "ha":
+		ha := convert.HomeAssistant{Host: *host, Slave: *slave, Prefix: *prefix}
+		if err := ha.Write(os.Stdout, &inv, *model); err != nil {
+			fmt.Println(err)
+		}
+		return
+	case "json":
+		if err := convert.WriteTemplates(os.Stdout, &inv, *model); err != nil {
+			fmt.Println(err)
+		}
+		return
+	default:
+		fmt.Printf("Don't know how to export %q\n", *export)
+		return
+	}
+
 	*format = strings.ReplaceAll(*format, "\\n", "\n") + "\n"
 
 	template, err := template.New("outut format").Parse(*format)
@@ -25,13 +54,10 @@ func main() {
 		return
 	}
 
-	var inv sungrow.Inverter
-	if err := inv.DefineFromYaml(*registers); err != nil {
-		fmt.Println(err)
-		return
-	}
+	regs := append([]sungrow.Register{}, inv.Registers.Input...)
+	regs = append(regs, inv.Registers.Computed...)
 
-	for _, reg := range inv.Registers.Input {
+	for _, reg := range regs {
 		if reg.Models.ContainsOrNull(*model) {
 			template.Execute(os.Stdout, reg)
 		}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/transport"
+)
+
+func main() {
+	uri := flag.String("uri", "", "Inverter connection string, eg: tcp://192.168.1.20")
+	registers := flag.String("regs", "", "Register definition file layered over the built in maps")
+	tz := flag.String("tz", "Local", "Time zone the inverter keeps, eg: Australia/Brisbane")
+	threshold := flag.Duration("threshold", 30*time.Second, "Correct the clock when it drifts further than this")
+	interval := flag.Duration("interval", 0, "Check the clock this often, once if 0")
+	dryRun := flag.Bool("n", false, "Report the drift without correcting it")
+	verbose := flag.Bool("v", false, "Log modbus transmissions")
+
+	flag.Parse()
+
+	if *uri == "" {
+		fmt.Println("Hey, you forgot to tell me which inverter")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	loc, err := time.LoadLocation(*tz)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var inv sungrow.Inverter
+	if err := inv.DefineDefault(*registers); err != nil {
+		log.Fatal(err)
+	}
+
+	client, err := transport.Dial(*uri)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	if *verbose {
+		client.LogTransmissions(log.Default())
+	}
+
+	// Learn the model so the right registers are used
+	err = inv.ReadWithSkip(client, func(r sungrow.Register, funcCode int) bool {
+		return r.Name != "device_type_code"
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	check := func() error {
+		if *dryRun {
+			drift, err := inv.ClockDrift(client, loc, time.Now)
+			if err != nil {
+				return err
+			}
+			log.Printf("inverter clock is %s ahead", drift)
+			return nil
+		}
+
+		drift, corrected, err := inv.SyncClock(client, loc, *threshold, time.Now)
+		if err != nil {
+			return err
+		}
+
+		if corrected {
+			log.Printf("inverter clock was %s ahead, corrected", drift)
+		} else {
+			log.Printf("inverter clock is %s ahead, within %s", drift, *threshold)
+		}
+
+		return nil
+	}
+
+	if *interval <= 0 {
+		if err := check(); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		if err := check(); err != nil {
+			log.Println(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
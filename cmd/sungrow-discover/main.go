@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/discovery"
+)
+
+func main() {
+	opts := discovery.DefaultOptions()
+
+	subnet := flag.String("subnet", "", "Subnet to scan, eg: 192.168.1.0/24")
+	registers := flag.String("regs", "", "Register definition file layered over the built in maps, used to name device types")
+	modbusPort := flag.Int("modbusPort", opts.ModbusPort, "Port of the modbus tcp server")
+	wsPort := flag.Int("wsPort", opts.WSPort, "Port of the websocket server")
+	slaveID := flag.Int("slaveID", int(opts.SlaveID), "Slave ID")
+	timeout := flag.Duration("timeout", opts.Timeout, "How long to wait for each host")
+	concurrency := flag.Int("concurrency", opts.Concurrency, "How many hosts to probe at once")
+	asJSON := flag.Bool("json", false, "Output the inventory as json")
+
+	flag.Parse()
+
+	if *subnet == "" {
+		fmt.Println("Hey, you forgot to tell me where to look")
+		flag.PrintDefaults()
+		return
+	}
+
+	opts.ModbusPort = *modbusPort
+	opts.WSPort = *wsPort
+	opts.SlaveID = byte(*slaveID)
+	opts.Timeout = *timeout
+	opts.Concurrency = *concurrency
+
+	if *registers != "" {
+		var inv sungrow.Inverter
+		if err := inv.DefineDefault(*registers); err != nil {
+			fmt.Println(err)
+			return
+		}
+		opts.Inverter = &inv
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	devices, err := discovery.Scan(ctx, *subnet, opts)
+	if err != nil {
+		fmt.Println("Failed to scan", err)
+		return
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(devices)
+		return
+	}
+
+	for _, d := range devices {
+		model := d.Model
+		if model == "" {
+			model = fmt.Sprintf("0x%X", d.DeviceTypeCode)
+		}
+
+		fmt.Printf("%-15s %-12s %-14s %s\n", d.Host, model, d.Serial, strings.Join(d.Transports, ","))
+		for _, a := range d.Attached {
+			fmt.Printf("%-15s   %-10s %-14s %s\n", "", a.Model, a.Serial, a.Name)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/ems"
+	"github.com/freman/sungrow/transport"
+)
+
+func main() {
+	uri := flag.String("uri", "", "Inverter connection string, eg: tcp://192.168.1.20")
+	registers := flag.String("regs", "", "Register definition file layered over the built in maps")
+	listen := flag.String("listen", ":8080", "Address to accept setpoints on")
+	timeout := flag.Duration("timeout", 10*time.Second, "How long the inverter waits for a heartbeat, 1s to 20s")
+	ttl := flag.Duration("ttl", 5*time.Minute, "Revert to self-consumption when no setpoint arrives for this long, 0 to disable")
+	verbose := flag.Bool("v", false, "Log modbus transmissions")
+
+	flag.Parse()
+
+	if *uri == "" {
+		fmt.Println("Hey, you forgot to tell me which inverter")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	if *timeout < ems.MinTimeout || *timeout > ems.MaxTimeout {
+		fmt.Printf("The timeout has to be between %s and %s\n", ems.MinTimeout, ems.MaxTimeout)
+		os.Exit(1)
+	}
+
+	var inv sungrow.Inverter
+	if err := inv.DefineDefault(*registers); err != nil {
+		log.Fatal(err)
+	}
+
+	client, err := transport.Dial(*uri)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	if *verbose {
+		client.LogTransmissions(log.Default())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	controller := ems.NewController(&inv, client)
+	controller.Timeout = *timeout
+	controller.SetpointTTL = *ttl
+	controller.Logger = log.Default()
+
+	srv := &http.Server{Addr: *listen, Handler: controller}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Println(err)
+			stop()
+		}
+	}()
+
+	err = controller.Run(ctx)
+	srv.Close()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
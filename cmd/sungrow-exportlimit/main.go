@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/exportlimit"
+	"github.com/freman/sungrow/transport"
+)
+
+func main() {
+	uri := flag.String("uri", "", "Inverter connection string, eg: tcp://192.168.1.20")
+	registers := flag.String("regs", "", "Register definition file layered over the built in maps")
+	scheduleFile := flag.String("schedule", "", "Export limit schedule file, optional")
+	listen := flag.String("listen", "", "Address to accept limit overrides on, eg: :8081")
+	interval := flag.Duration("interval", 30*time.Second, "How often to check the limit")
+	minInterval := flag.Duration("minInterval", 5*time.Minute, "Least time between writes that loosen the limit")
+	verbose := flag.Bool("v", false, "Log modbus transmissions")
+
+	flag.Parse()
+
+	if *uri == "" || (*scheduleFile == "" && *listen == "") {
+		fmt.Println("Hey, you forgot to tell me which inverter and where the limits come from")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	var inv sungrow.Inverter
+	if err := inv.DefineDefault(*registers); err != nil {
+		log.Fatal(err)
+	}
+
+	var schedule *exportlimit.Schedule
+	if *scheduleFile != "" {
+		var err error
+		if schedule, err = exportlimit.LoadFile(*scheduleFile); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	client, err := transport.Dial(*uri)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	if *verbose {
+		client.LogTransmissions(log.Default())
+	}
+
+	controller := exportlimit.NewController(&inv, client, schedule)
+	controller.MinInterval = *minInterval
+	controller.Logger = log.Default()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *listen != "" {
+		srv := &http.Server{Addr: *listen, Handler: controller}
+		defer srv.Close()
+
+		go func() {
+			if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Println(err)
+				stop()
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		if err := controller.Step(time.Now()); err != nil {
+			log.Println(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
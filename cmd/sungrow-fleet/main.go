@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/freman/sungrow/fleet"
+)
+
+func main() {
+	configFile := flag.String("config", "", "Fleet configuration file")
+	listen := flag.String("listen", ":8080", "Address to publish the devices and totals on")
+	verbose := flag.Bool("v", false, "Log polling failures")
+
+	flag.Parse()
+
+	if *configFile == "" {
+		fmt.Println("Hey, you forgot to tell me where the fleet is")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	cfg, err := fleet.LoadConfigFile(*configFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	manager, err := fleet.NewManager(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *verbose {
+		manager.Logger = log.Default()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	srv := &http.Server{Addr: *listen, Handler: manager}
+	defer srv.Close()
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Println(err)
+			stop()
+		}
+	}()
+
+	manager.Run(ctx)
+}
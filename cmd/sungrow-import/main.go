@@ -0,0 +1,110 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/convert"
+)
+
+func main() {
+	format := flag.String("format", "", "Format of the input, ha, modbus4mqtt or csv, guessed from the file name if not set")
+	models := flag.String("models", "", "Comma separated models to list on the translated registers")
+	holding := flag.Bool("holding", false, "CSV registers are holding registers unless they say otherwise")
+	out := flag.String("o", "", "File to write the register definition to, standard out if not set")
+	diff := flag.Bool("diff", false, "List where the translation disagrees with the register definition rather than writing it")
+	registers := flag.String("regs", "", "Register definition file layered over the built in maps, for -diff")
+
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Println("Hey, you forgot to tell me what to import")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	name := flag.Arg(0)
+	f, err := os.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	if *format == "" {
+		*format = guess(name)
+	}
+
+	var res *convert.Result
+	switch *format {
+	case "ha":
+		res, err = convert.FromHomeAssistant(f)
+	case "modbus4mqtt":
+		res, err = convert.FromModbus4MQTT(f)
+	case "csv":
+		res, err = convert.FromCSV(f, *holding)
+	default:
+		log.Fatalf("don't know the %q format", *format)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, s := range res.Skipped {
+		log.Printf("skipped %s", s)
+	}
+
+	var list []string
+	if *models != "" {
+		list = strings.Split(*models, ",")
+		res.SetModels(list...)
+	}
+
+	if *diff {
+		var inv sungrow.Inverter
+		if err := inv.DefineDefault(*registers); err != nil {
+			log.Fatal(err)
+		}
+
+		model := ""
+		if len(list) > 0 {
+			model = list[0]
+		}
+
+		for _, d := range convert.Compare(res.Registers, &inv, model) {
+			fmt.Println(d)
+		}
+		return
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		o, err := os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer o.Close()
+		w = o
+	}
+
+	if err := convert.WriteYAML(w, res.Registers); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func guess(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return "csv"
+	}
+
+	if strings.Contains(strings.ToLower(filepath.Base(name)), "modbus4mqtt") {
+		return "modbus4mqtt"
+	}
+	return "ha"
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/learn"
+	"github.com/freman/sungrow/transport"
+)
+
+func main() {
+	var uris []string
+	flag.Func("uri", "Inverter connection string, eg: tcp://192.168.1.20 or replay://capture.jsonl, repeat for more devices", func(s string) error {
+		uris = append(uris, s)
+		return nil
+	})
+	registers := flag.String("regs", "maps/sungrow.yml", "Register definition file to learn about, read on its own rather than layered over the built in maps as it is what -apply rewrites")
+	apply := flag.Bool("apply", false, "Write the changes to the register definition rather than just proposing them")
+	all := flag.Bool("all", false, "Report every register, not just those that disagree with the definition")
+	verbose := flag.Bool("v", false, "Log modbus transmissions")
+
+	flag.Parse()
+
+	if len(uris) == 0 {
+		fmt.Println("Hey, you forgot to tell me which inverter")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	src, err := os.ReadFile(*registers)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var inv sungrow.Inverter
+	if err := inv.DefineFromYaml(*registers); err != nil {
+		log.Fatal(err)
+	}
+
+	var changes []learn.Change
+	seen := map[string]bool{}
+
+	for _, uri := range uris {
+		client, err := transport.Dial(uri)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if *verbose {
+			client.LogTransmissions(log.Default())
+		}
+
+		model, results, err := learn.Probe(&inv, client)
+		client.Close()
+		if err != nil {
+			if model == "" {
+				log.Printf("%s: %v", uri, err)
+				continue
+			}
+			log.Printf("%s: %v, using what was read", uri, err)
+		}
+
+		fmt.Printf("%s: %s\n", uri, model)
+		for _, r := range results {
+			agrees := r.Status == learn.Supported && r.Listed || r.Status == learn.Refused && !r.Listed
+			if agrees && !*all {
+				continue
+			}
+
+			listed := "unlisted"
+			if r.Listed {
+				listed = "listed"
+			}
+
+			line := fmt.Sprintf("  %-7s %5d %-40s %-9s %s", r.Bank(), r.Address, r.Name, r.Status, listed)
+			if r.Err != nil && r.Status == learn.Unknown {
+				line += ": " + r.Err.Error()
+			}
+			fmt.Println(line)
+		}
+
+		proposed, notes := learn.Propose(&inv, model, results)
+		for _, n := range notes {
+			fmt.Println("  note:", n)
+		}
+
+		for _, c := range proposed {
+			// Devices of the same model will agree
+			if seen[c.String()] {
+				continue
+			}
+			seen[c.String()] = true
+			changes = append(changes, c)
+		}
+	}
+
+	if len(changes) == 0 {
+		fmt.Println("no changes")
+		return
+	}
+
+	for _, c := range changes {
+		fmt.Println(c)
+	}
+
+	if !*apply {
+		return
+	}
+
+	out, err := learn.Apply(src, changes)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	info, err := os.Stat(*registers)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*registers, out, info.Mode()); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("updated %s\n", *registers)
+}
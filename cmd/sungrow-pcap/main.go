@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/capture"
+)
+
+func main() {
+	registers := flag.String("regs", "", "Register definition file layered over the built in maps")
+	ports := flag.String("ports", "502", "Comma separated ports Modbus servers listen on")
+	jsonl := flag.Bool("json", false, "Print JSON lines instead of a timeline")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] capture.pcap [capture.pcapng ...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var serverPorts []uint16
+	for _, s := range strings.Split(*ports, ",") {
+		p, err := strconv.ParseUint(strings.TrimSpace(s), 10, 16)
+		if err != nil {
+			log.Fatalf("invalid port %q: %v", s, err)
+		}
+		serverPorts = append(serverPorts, uint16(p))
+	}
+
+	var inv sungrow.Inverter
+	if err := inv.DefineDefault(*registers); err != nil {
+		log.Fatal(err)
+	}
+
+	annotator := capture.NewAnnotator(&inv)
+	enc := json.NewEncoder(os.Stdout)
+
+	for _, name := range flag.Args() {
+		if err := decode(name, serverPorts, func(e capture.Exchange) {
+			an := annotator.Annotate(e)
+			if *jsonl {
+				enc.Encode(an)
+				return
+			}
+			fmt.Println(an)
+		}); err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+	}
+}
+
+func decode(name string, ports []uint16, fn func(capture.Exchange)) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	r, err := capture.NewReader(f)
+	if err != nil {
+		return err
+	}
+
+	d := capture.NewDecoder(ports...)
+	for {
+		p, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// Captures cut short by ^C are common, keep what was read
+			log.Printf("%s: %v", name, err)
+			break
+		}
+
+		for _, e := range d.Add(p) {
+			fn(e)
+		}
+	}
+
+	for _, e := range d.Flush() {
+		fn(e)
+	}
+
+	return nil
+}
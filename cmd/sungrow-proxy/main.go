@@ -0,0 +1,114 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/freman/sungrow/proxy"
+	"github.com/freman/sungrow/transport"
+	"github.com/goburrow/modbus"
+)
+
+func main() {
+	addr := flag.String("addr", "", "Address of your inverter, eg: 10.0.0.84:502 (or 10.0.0.84 for http)")
+	upstream := flag.String("upstream", "tcp", "How to talk to the inverter, tcp or http")
+	httpPort := flag.Int("httpPort", 80, "Port of the regular http server")
+	wsPort := flag.Int("wsPort", 8082, "Port of the websocket server")
+	gateway := flag.Bool("gateway", false, "Repair non-standard responses for third party tools")
+	listen := flag.String("listen", ":5020", "Address to serve modbus tcp on")
+	slaveID := flag.Int("slaveID", 1, "Slave ID, downstream requests for other unit IDs are refused, 0 sends each on to the unit it is for")
+	cache := flag.Duration("cache", 0, "How long to cache reads for, eg: 2s")
+	hot := flag.String("hot", "", "Register ranges to cache, eg: 5000-5050,13000-13050 (default all)")
+	verbose := flag.Bool("v", false, "Log upstream traffic")
+
+	flag.Parse()
+
+	if *addr == "" {
+		fmt.Println("Hey, you forgot to tell me what to talk to")
+		flag.PrintDefaults()
+		return
+	}
+
+	cacheable, err := parseRanges(*hot)
+	if err != nil {
+		fmt.Println("Failed to parse hot registers", err)
+		return
+	}
+
+	var handler modbus.ClientHandler
+	switch *upstream {
+	case "tcp":
+		h := transport.NewBorkedTCPClient(*addr)
+		h.SlaveID = byte(*slaveID)
+		if *verbose {
+			h.Logger = log.Default()
+		}
+		handler = h
+	case "http":
+		h := transport.NewHTTPClientHandler(*addr)
+		h.SlaveID = byte(*slaveID)
+		h.HTTPPort = *httpPort
+		h.WSPort = *wsPort
+		if *verbose {
+			h.Logger = log.Default()
+		}
+		handler = h
+	default:
+		fmt.Printf("Unknown upstream %q\n", *upstream)
+		return
+	}
+
+	server := proxy.NewServer(handler)
+	server.Normalise = *gateway
+	server.UnitID = byte(*slaveID)
+	server.CacheTTL = *cache
+	server.Cacheable = cacheable
+	server.Logger = log.Default()
+
+	log.Printf("proxying %s on %s", *addr, *listen)
+	log.Fatal(server.ListenAndServe(*listen))
+}
+
+// parseRanges parses register ranges as they appear in sungrow.yml, the
+// addresses on the wire are one less.
+func parseRanges(s string) (func(funcCode byte, address, quantity uint16) bool, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	type span struct{ from, to int }
+	var spans []span
+
+	for _, r := range strings.Split(s, ",") {
+		from, to, found := strings.Cut(strings.TrimSpace(r), "-")
+		if !found {
+			to = from
+		}
+
+		f, err := strconv.Atoi(from)
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := strconv.Atoi(to)
+		if err != nil {
+			return nil, err
+		}
+
+		spans = append(spans, span{f, t})
+	}
+
+	return func(funcCode byte, address, quantity uint16) bool {
+		first := int(address) + 1
+		last := first + int(quantity) - 1
+		for _, sp := range spans {
+			if first >= sp.from && last <= sp.to {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
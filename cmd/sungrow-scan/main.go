@@ -0,0 +1,130 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/scan"
+	"github.com/freman/sungrow/transport"
+)
+
+func main() {
+	uri := flag.String("uri", "", "Inverter connection string, eg: tcp://192.168.1.20")
+	registers := flag.String("regs", "", "Register definition file layered over the built in maps")
+	input := flag.String("input", "4950-5200,6000-6100,13000-13150", "Input register ranges to scan, empty for none")
+	holding := flag.String("holding", "4950-5050,13000-13150", "Holding register ranges to scan, empty for none")
+	maxBlock := flag.Int("block", 64, "Largest block of registers to read at once")
+	delay := flag.Duration("delay", 100*time.Millisecond, "Pause between requests")
+	samples := flag.Int("samples", 5, "Times to sample what answers")
+	interval := flag.Duration("interval", 10*time.Second, "Time between samples")
+	stub := flag.String("stub", "", "File to write a register definition of the unknown registers to")
+	zeros := flag.Bool("zeros", false, "Include unknown registers that only ever read zero in the stub")
+	verbose := flag.Bool("v", false, "Log modbus transmissions")
+
+	flag.Parse()
+
+	if *uri == "" {
+		fmt.Println("Hey, you forgot to tell me which inverter")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	var ranges []scan.Range
+	for _, spec := range []struct {
+		holding bool
+		ranges  string
+	}{{false, *input}, {true, *holding}} {
+		r, err := parseRanges(spec.ranges, spec.holding)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ranges = append(ranges, r...)
+	}
+
+	var inv sungrow.Inverter
+	if err := inv.DefineDefault(*registers); err != nil {
+		log.Fatal(err)
+	}
+
+	client, err := transport.Dial(*uri)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	if *verbose {
+		client.LogTransmissions(log.Default())
+	}
+
+	scanner := scan.New(client)
+	scanner.MaxBlock = *maxBlock
+	scanner.Delay = *delay
+	scanner.Logger = log.Default()
+
+	var found []scan.Address
+	for _, r := range ranges {
+		log.Printf("scanning %s", r)
+		addresses, err := scanner.Scan(r)
+		found = append(found, addresses...)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	if *samples > 1 {
+		log.Printf("sampling %d more times", *samples-1)
+		if err := scanner.Sample(found, *samples-1, *interval); err != nil {
+			log.Println(err)
+		}
+	}
+
+	findings := scan.Analyse(found, &inv)
+	scan.WriteReport(os.Stdout, findings)
+
+	if *stub != "" {
+		f, err := os.Create(*stub)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+
+		scan.WriteStub(f, findings, *zeros)
+	}
+}
+
+func parseRanges(s string, holding bool) ([]scan.Range, error) {
+	var ranges []scan.Range
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		bounds := strings.SplitN(part, "-", 2)
+		start, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %q: %w", part, err)
+		}
+
+		end := start
+		if len(bounds) == 2 {
+			if end, err = strconv.Atoi(bounds[1]); err != nil {
+				return nil, fmt.Errorf("invalid range %q: %w", part, err)
+			}
+		}
+
+		if start < 1 || end < start || end > 0x10000 {
+			return nil, fmt.Errorf("invalid range %q", part)
+		}
+
+		ranges = append(ranges, scan.Range{Holding: holding, Start: start, End: end})
+	}
+
+	return ranges, nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/schedule"
+	"github.com/freman/sungrow/transport"
+)
+
+func main() {
+	uri := flag.String("uri", "", "Inverter connection string, eg: tcp://192.168.1.20")
+	registers := flag.String("regs", "", "Register definition file layered over the built in maps")
+	scheduleFile := flag.String("schedule", "schedule.yml", "Schedule file")
+	interval := flag.Duration("interval", time.Minute, "How often to check the schedule")
+	verbose := flag.Bool("v", false, "Log modbus transmissions")
+
+	flag.Parse()
+
+	if *uri == "" {
+		fmt.Println("Hey, you forgot to tell me which inverter")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	var inv sungrow.Inverter
+	if err := inv.DefineDefault(*registers); err != nil {
+		log.Fatal(err)
+	}
+
+	s, err := schedule.LoadFile(*scheduleFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client, err := transport.Dial(*uri)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer client.Close()
+
+	if *verbose {
+		client.LogTransmissions(log.Default())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	scheduler := schedule.NewScheduler(&inv, client, s)
+	scheduler.Interval = *interval
+	scheduler.Logger = log.Default()
+
+	if err := scheduler.Run(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/capture"
+	"github.com/freman/sungrow/sniff"
+)
+
+func main() {
+	addr := flag.String("addr", "", "Address of your inverter, eg: 10.0.0.84:502")
+	listen := flag.String("listen", ":5020", "Address for the client to connect to instead of the inverter")
+	registers := flag.String("regs", "", "Register definition file layered over the built in maps")
+	logFile := flag.String("log", "-", "File to write the exchanges to as JSON lines, - for stdout")
+	web := flag.String("web", "", "Address to serve the live web view on, eg: :8080")
+	history := flag.Int("history", 500, "Exchanges kept for the web view")
+
+	flag.Parse()
+
+	if *addr == "" {
+		fmt.Println("Hey, you forgot to tell me what to talk to")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	var inv sungrow.Inverter
+	if err := inv.DefineDefault(*registers); err != nil {
+		log.Fatal(err)
+	}
+
+	s := sniff.New(*addr, capture.NewAnnotator(&inv))
+	s.History = *history
+	s.Logger = log.New(os.Stderr, "", log.LstdFlags)
+
+	switch *logFile {
+	case "":
+	case "-":
+		s.Log = os.Stdout
+	default:
+		f, err := os.OpenFile(*logFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		s.Log = f
+	}
+
+	if *web != "" {
+		go func() {
+			log.Fatal(http.ListenAndServe(*web, s))
+		}()
+	}
+
+	log.Fatal(s.ListenAndServe(*listen))
+}
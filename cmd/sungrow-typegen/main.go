@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/typegen"
+)
+
+func main() {
+	registers := flag.String("regs", "", "Register definition file layered over the built in maps")
+	out := flag.String("o", "", "File to write, standard out if not set")
+	pkg := flag.String("pkg", "typed", "Package of the generated code")
+
+	flag.Parse()
+
+	var inv sungrow.Inverter
+	if err := inv.DefineDefault(*registers); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := typegen.Generate(&inv, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *out == "" {
+		os.Stdout.Write(src)
+		return
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
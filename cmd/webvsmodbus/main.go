@@ -17,7 +17,7 @@ func main() {
 	wsPort := flag.Int("wsPort", 8082, "Port of the websocket server")
 	slaveID := flag.Int("slaveID", 1, "Slave ID")
 
-	inverter := flag.String("regs", "sungrow.yml", "Register definition file")
+	inverter := flag.String("regs", "", "Register definition file layered over the built in maps")
 
 	flag.Parse()
 
@@ -78,7 +78,7 @@ func tcpInverter(addr, yamlFile string, slaveID int) (*sungrow.Inverter, error)
 	client := modbus.NewClient(handler)
 
 	var inv sungrow.Inverter
-	if err := inv.DefineFromYaml(yamlFile); err != nil {
+	if err := inv.DefineDefault(yamlFile); err != nil {
 		return nil, err
 	}
 
@@ -96,7 +96,7 @@ func httpInverter(addr, yamlFile string, httpPort, wsPort, slaveID int) (*sungro
 	client := modbus.NewClient(handler)
 
 	var inv sungrow.Inverter
-	if err := inv.DefineFromYaml(yamlFile); err != nil {
+	if err := inv.DefineDefault(yamlFile); err != nil {
 		return nil, err
 	}
 
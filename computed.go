@@ -0,0 +1,157 @@
+package sungrow
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Value returns the numeric value of the named register from the last read,
+// the name may be prefixed with input., holding. or computed. to pick a bank.
+func (i *Inverter) Value(name string) (float64, bool) {
+	sets := [][]Register{i.Registers.Input, i.Registers.Holding, i.Registers.Computed}
+
+	bank, name := splitBank(name)
+	switch bank {
+	case "input":
+		sets = sets[0:1]
+	case "holding":
+		sets = sets[1:2]
+	case "computed":
+		sets = sets[2:3]
+	}
+
+	for _, set := range sets {
+		for idx := range set {
+			r := &set[idx]
+			if r.Name != name || !r.Supported || r.Err != nil {
+				continue
+			}
+
+			if f, isa := r.Float(); isa {
+				return f, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// Label returns the value of the named register with the label, ignoring
+// case, spaces and punctuation so "off-grid" finds "Off grid". The name may be
+// prefixed with a bank as for Value.
+func (i *Inverter) Label(name, label string) (float64, bool) {
+	sets := [][]Register{i.Registers.Input, i.Registers.Holding}
+
+	bank, name := splitBank(name)
+	switch bank {
+	case "input":
+		sets = sets[0:1]
+	case "holding":
+		sets = sets[1:2]
+	case "computed":
+		// Computed registers have no labels
+		sets = nil
+	}
+
+	want := normaliseLabel(label)
+	for _, set := range sets {
+		for _, r := range set {
+			if r.Name != name {
+				continue
+			}
+
+			for v, l := range r.Values {
+				if l, _ := deviceModel(l); normaliseLabel(l) == want {
+					return float64(v), true
+				}
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// splitBank separates the bank from a name prefixed with input., holding. or
+// computed., names without one are returned as they are
+func splitBank(name string) (string, string) {
+	if bank, n, found := strings.Cut(name, "."); found {
+		switch bank {
+		case "input", "holding", "computed":
+			return bank, n
+		}
+	}
+	return "", name
+}
+
+// ParseExpression parses a formula, resolving labels compared with registers
+// from their values.
+func (i *Inverter) ParseExpression(s string) (*Expression, error) {
+	return ParseExpressionLabels(s, i.Label)
+}
+
+func normaliseLabel(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
+
+// Compute evaluates the computed registers against the values from the last
+// read, registers whose formula refers to something that wasn't read are
+// marked as unsupported.
+func (i *Inverter) Compute() {
+	model := i.Model()
+
+	for idx := range i.Registers.Computed {
+		r := &i.Registers.Computed[idx]
+		r.Value, r.Err, r.Supported = nil, nil, false
+
+		if model != "" && !r.Models.ContainsOrNull(model) {
+			continue
+		}
+
+		if r.expr == nil {
+			expr, err := i.ParseExpression(r.Formula)
+			if err != nil {
+				r.Supported, r.Err = true, err
+				continue
+			}
+			r.expr = expr
+		}
+
+		v, err := r.expr.Eval(i.Value)
+		if errors.Is(err, ErrMissing) {
+			continue
+		}
+
+		r.Supported = true
+		if err != nil {
+			r.Err = err
+			continue
+		}
+
+		r.Value = v * r.Scale
+	}
+}
+
+// validateComputed parses the formulas once for Compute
+func (i *Inverter) validateComputed() error {
+	for idx := range i.Registers.Computed {
+		r := &i.Registers.Computed[idx]
+		if r.Formula == "" {
+			return fmt.Errorf("computed register %q has no formula", r.Name)
+		}
+
+		expr, err := i.ParseExpression(r.Formula)
+		if err != nil {
+			return fmt.Errorf("computed register %q: %w", r.Name, err)
+		}
+		r.expr = expr
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package sungrow_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/internal/modbustest"
+	"github.com/stretchr/testify/require"
+)
+
+const computedRegisters = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0x147: "SG5KTL-MT"
+    - address: 5031
+      name: "total_active_power"
+      unit: "W"
+      type: "uint32"
+    - address: 5083
+      name: "meter_power"
+      unit: "W"
+      type: "int32"
+  computed:
+    - name: "grid_import_power"
+      unit: "W"
+      formula: "max(meter_power, 0)"
+    - name: "grid_export_power"
+      unit: "W"
+      formula: "max(-meter_power, 0)"
+    - name: "house_consumption"
+      unit: "W"
+      formula: "total_active_power + meter_power"
+    - name: "house_consumption_kw"
+      unit: "kW"
+      scale: 0.001
+      formula: "house_consumption"
+    - name: "battery_thing"
+      formula: "battery_power * 2"
+    - name: "not_this_one"
+      formula: "1"
+      models: ["SH10RT"]
+`
+
+func TestComputed(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(computedRegisters)))
+
+	// meter_power of -1500 (exporting), low word first
+	requires.NoError(inv.Read(&modbustest.Device{
+		Input: map[uint16]uint16{
+			4999: 0x147,
+			5030: 4000,
+			5031: 0,
+			5082: 0xFA24,
+			5083: 0xFFFF,
+		},
+	}))
+
+	requires.Equal(-1500.0, inv.Registers.Input[2].Value)
+
+	computed := inv.Registers.Computed
+	requires.Equal(0.0, computed[0].Value)
+	requires.Equal(1500.0, computed[1].Value)
+	requires.Equal(2500.0, computed[2].Value)
+	requires.Equal(2.5, computed[3].Value)
+	requires.False(computed[4].Supported)
+	requires.False(computed[5].Supported)
+
+	v, isa := inv.Value("computed.house_consumption")
+	requires.True(isa)
+	requires.Equal(2500.0, v)
+
+	// Only a bank is stripped, not any prefix
+	_, isa = inv.Value("inptu.house_consumption")
+	requires.False(isa)
+
+	requires.Equal(computed[2], *inv.Registers.Find("house_consumption", "SG5KTL-MT"))
+	requires.Len(inv.Registers.All(), 9)
+
+	requires.Error(inv.Define(strings.NewReader("registers:\n  computed:\n    - name: broken\n      formula: \"1 +\"\n")))
+}
@@ -0,0 +1,282 @@
+// Package convert translates register maps maintained by other projects to
+// and from the register definition format.
+package convert
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/freman/sungrow"
+)
+
+// Result is a translated register map.
+type Result struct {
+	Registers sungrow.Registers
+	// Entries that couldn't be translated
+	Skipped []Skipped
+}
+
+// Skipped is an entry that couldn't be translated and why.
+type Skipped struct {
+	Entry  string
+	Reason string
+}
+
+func (s Skipped) String() string {
+	return s.Entry + ": " + s.Reason
+}
+
+func (res *Result) skip(entry, format string, v ...interface{}) {
+	res.Skipped = append(res.Skipped, Skipped{Entry: entry, Reason: fmt.Sprintf(format, v...)})
+}
+
+func (res *Result) add(holding bool, r sungrow.Register) {
+	if holding {
+		res.Registers.Holding = append(res.Registers.Holding, r)
+		return
+	}
+	res.Registers.Input = append(res.Registers.Input, r)
+}
+
+// SetModels lists the models on every translated register, the formats
+// don't say which models their registers are for.
+func (res *Result) SetModels(models ...string) {
+	for _, regs := range [][]sungrow.Register{res.Registers.Input, res.Registers.Holding} {
+		for i := range regs {
+			regs[i].Models = append(sungrow.Models(nil), models...)
+		}
+	}
+}
+
+// newRegister has the defaults of a register read from a definition
+func newRegister(address int, name string) sungrow.Register {
+	return sungrow.Register{Address: address, Name: name, Scale: 1, Type: "uint16", Count: 1}
+}
+
+// registerType translates the common spellings of a data type, returning
+// the type and the 16 bit registers of one value
+func registerType(s string) (string, int, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "uint16", "u16", "uint", "unsigned", "word":
+		return "uint16", 1, true
+	case "int16", "s16", "i16", "int", "signed":
+		return "int16", 1, true
+	case "uint32", "u32", "dword":
+		return "uint32", 2, true
+	case "int32", "s32", "i32":
+		return "int32", 2, true
+	case "string", "utf-8", "utf8", "ascii", "str":
+		return "string", 1, true
+	}
+	return "", 0, false
+}
+
+// snake makes a register name of a label, "Daily PV Generation" becomes
+// daily_pv_generation
+func snake(s string) string {
+	var words []string
+	for _, w := range strings.FieldsFunc(s, func(r rune) bool {
+		return r > unicode.MaxASCII || !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		words = append(words, strings.ToLower(w))
+	}
+	return strings.Join(words, "_")
+}
+
+// parseInt reads decimal or 0x prefixed hex
+func parseInt(s string) (int, error) {
+	v, err := strconv.ParseInt(strings.TrimSpace(s), 0, 64)
+	return int(v), err
+}
+
+// valuePair finds "value: label" pairs, as in a dictionary or a note
+var valuePair = regexp.MustCompile(`['"]?(0[xX][0-9A-Fa-f]+|-?\d+)['"]?\s*[:=：]\s*(?:'([^']*)'|"([^"]*)"|([^,;\n}]+))`)
+
+// parseValues reads a table of values and labels
+func parseValues(s string) map[int]interface{} {
+	values := map[int]interface{}{}
+	for _, m := range valuePair.FindAllStringSubmatch(s, -1) {
+		v, err := parseInt(m[1])
+		if err != nil {
+			continue
+		}
+
+		label := strings.TrimSpace(m[2] + m[3] + m[4])
+		if label == "" {
+			continue
+		}
+		values[v] = label
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// WriteYAML writes the registers in the register definition format.
+func WriteYAML(w io.Writer, regs sungrow.Registers) error {
+	var b strings.Builder
+	b.WriteString("registers:\n")
+
+	for _, bank := range []struct {
+		name string
+		regs []sungrow.Register
+	}{{"input", regs.Input}, {"holding", regs.Holding}, {"computed", regs.Computed}} {
+		if len(bank.regs) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, "  %s:\n", bank.name)
+		for _, r := range bank.regs {
+			writeRegister(&b, r, bank.name == "computed")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeRegister(b *strings.Builder, r sungrow.Register, computed bool) {
+	if computed {
+		fmt.Fprintf(b, "    - name: %q\n", r.Name)
+	} else {
+		fmt.Fprintf(b, "    - address: %d\n", r.Address)
+		fmt.Fprintf(b, "      name: %q\n", r.Name)
+	}
+
+	if unit := r.GetUnit(); unit != "" {
+		fmt.Fprintf(b, "      unit: %q\n", unit)
+	}
+	if r.Scale != 0 && r.Scale != 1 {
+		fmt.Fprintf(b, "      scale: %s\n", strconv.FormatFloat(r.Scale, 'g', -1, 64))
+	}
+	if r.Type != "" && r.Type != "uint16" {
+		fmt.Fprintf(b, "      type: %q\n", r.Type)
+	}
+	if r.Count > 1 {
+		fmt.Fprintf(b, "      count: %d\n", r.Count)
+	}
+	if r.Formula != "" {
+		fmt.Fprintf(b, "      formula: %q\n", r.Formula)
+	}
+
+	if len(r.Values) > 0 {
+		b.WriteString("      values:\n")
+		for _, v := range sortedKeys(r.Values) {
+			fmt.Fprintf(b, "        0x%X: %q\n", v, fmt.Sprint(r.Values[v]))
+		}
+	}
+
+	if len(r.Bits) > 0 {
+		b.WriteString("      bits:\n")
+		masks := make([]int, 0, len(r.Bits))
+		for m := range r.Bits {
+			masks = append(masks, m)
+		}
+		sort.Ints(masks)
+		for _, m := range masks {
+			fmt.Fprintf(b, "        0x%X: %q\n", m, r.Bits[m])
+		}
+	}
+
+	if len(r.Models) > 0 {
+		quoted := make([]string, len(r.Models))
+		for i, m := range r.Models {
+			quoted[i] = strconv.Quote(m)
+		}
+		fmt.Fprintf(b, "      models: [%s]\n", strings.Join(quoted, ", "))
+	}
+}
+
+func sortedKeys(m map[int]interface{}) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// Difference is where an imported register disagrees with the definition.
+type Difference struct {
+	Holding bool
+	Address int
+	Name    string
+	// What differs, empty for registers the definition doesn't have
+	Fields []string
+}
+
+func (d Difference) String() string {
+	bank := "input"
+	if d.Holding {
+		bank = "holding"
+	}
+
+	if len(d.Fields) == 0 {
+		return fmt.Sprintf("%s %d %s: not in the definition", bank, d.Address, d.Name)
+	}
+	return fmt.Sprintf("%s %d %s: %s", bank, d.Address, d.Name, strings.Join(d.Fields, ", "))
+}
+
+// Compare lists the imported registers that the definition doesn't have, or
+// has with a different unit, scale, type or values, for the model.
+func Compare(imported sungrow.Registers, inv *sungrow.Inverter, model string) []Difference {
+	var diffs []Difference
+
+	for _, bank := range []struct {
+		holding  bool
+		imported []sungrow.Register
+		defined  []sungrow.Register
+	}{{false, imported.Input, inv.Registers.Input}, {true, imported.Holding, inv.Registers.Holding}} {
+		for _, r := range bank.imported {
+			var known *sungrow.Register
+			for i := range bank.defined {
+				d := &bank.defined[i]
+				if d.Address == r.Address && (model == "" || d.Models.ContainsOrNull(model)) {
+					known = d
+					break
+				}
+			}
+
+			diff := Difference{Holding: bank.holding, Address: r.Address, Name: r.Name}
+			if known == nil {
+				diffs = append(diffs, diff)
+				continue
+			}
+
+			diff.Name = known.Name
+			if known.GetUnit() != r.GetUnit() {
+				diff.Fields = append(diff.Fields, fmt.Sprintf("unit %q not %q", r.GetUnit(), known.GetUnit()))
+			}
+			if known.Scale != r.Scale {
+				diff.Fields = append(diff.Fields, fmt.Sprintf("scale %v not %v", r.Scale, known.Scale))
+			}
+			if known.Type != r.Type || known.Type == "string" && known.Count != r.Count {
+				diff.Fields = append(diff.Fields, fmt.Sprintf("type %s x%d not %s x%d", r.Type, r.Count, known.Type, known.Count))
+			}
+			for _, v := range sortedKeys(r.Values) {
+				if label, isa := known.Values[v]; !isa || fmt.Sprint(label) != fmt.Sprint(r.Values[v]) && !isModel(label) {
+					diff.Fields = append(diff.Fields, fmt.Sprintf("value 0x%X %q", v, fmt.Sprint(r.Values[v])))
+				}
+			}
+
+			if len(diff.Fields) > 0 {
+				diffs = append(diffs, diff)
+			}
+		}
+	}
+
+	return diffs
+}
+
+// isModel reports device_type_code values, which are maps in the definition
+func isModel(label interface{}) bool {
+	_, isa := label.(map[string]interface{})
+	return isa
+}
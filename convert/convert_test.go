@@ -0,0 +1,372 @@
+package convert_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/convert"
+	"github.com/stretchr/testify/require"
+)
+
+const homeAssistant = `modbus:
+  - name: sungrow
+    type: tcp
+    host: 192.168.1.20
+    port: 502
+    sensors:
+      - name: Daily PV Generation
+        unique_id: sg_daily_pv_generation
+        slave: 1
+        address: 13001
+        input_type: input
+        data_type: uint16
+        scale: 0.1
+        precision: 1
+        unit_of_measurement: kWh
+        device_class: energy
+      - name: Total active power
+        address: 13033
+        input_type: input
+        data_type: int32
+        swap: word
+        unit_of_measurement: W
+      - name: Backwards power
+        address: 13035
+        input_type: input
+        data_type: int32
+      - name: Serial number
+        address: 4989
+        input_type: input
+        data_type: string
+        count: 10
+      - name: EMS mode
+        address: 13049
+        data_type: uint16
+      - name: Temperature
+        address: 5007
+        input_type: input
+        data_type: float32
+template:
+  - sensor:
+      - name: EMS mode selection
+        state: >-
+          {{ {0: 'Self-consumption mode (default)', 2: "Forced mode", 0x0004: 'External EMS'}[states('sensor.ems_mode')|int] }}
+`
+
+func TestFromHomeAssistant(t *testing.T) {
+	requires := require.New(t)
+
+	res, err := convert.FromHomeAssistant(strings.NewReader(homeAssistant))
+	requires.NoError(err)
+
+	requires.Len(res.Registers.Input, 3)
+	requires.Len(res.Registers.Holding, 1)
+
+	daily := res.Registers.Input[0]
+	requires.Equal(13002, daily.Address)
+	requires.Equal("daily_pv_generation", daily.Name)
+	requires.Equal(0.1, daily.Scale)
+	requires.Equal("kWh", daily.GetUnit())
+
+	requires.Equal("int32", res.Registers.Input[1].Type)
+
+	serial := res.Registers.Input[2]
+	requires.Equal("string", serial.Type)
+	requires.Equal(uint(10), serial.Count)
+	requires.Equal(4990, serial.Address)
+
+	ems := res.Registers.Holding[0]
+	requires.Equal(13050, ems.Address)
+	requires.Equal(map[int]interface{}{0: "Self-consumption mode (default)", 2: "Forced mode", 4: "External EMS"}, ems.Values)
+
+	requires.Len(res.Skipped, 2)
+	requires.Contains(res.Skipped[0].String(), "Backwards power: 32 bit value with the high word first")
+	requires.Contains(res.Skipped[1].String(), "Temperature: data_type float32")
+
+	// Just the hubs, as from a package
+	res, err = convert.FromHomeAssistant(strings.NewReader(`- name: hub
+  sensors:
+    - name: Grid frequency
+      address: 5035
+      input_type: input
+      scale: 0.1
+      unit_of_measurement: Hz
+`))
+	requires.NoError(err)
+	requires.Len(res.Registers.Input, 1)
+	requires.Equal("grid_frequency", res.Registers.Input[0].Name)
+}
+
+const modbus4mqtt = `ip: 192.168.1.89
+port: 502
+update_rate: 5
+address_offset: 0
+variant: sungrow
+word_order: lowhigh
+registers:
+  - pub_topic: "daily_pv_generation"
+    address: 13001
+    table: 'input'
+    scale: 0.1
+  - pub_topic: "total_pv_generation"
+    address: 13002
+    table: 'input'
+    type: uint32
+    scale: 0.1
+  - pub_topic: "system_state"
+    address: 12999
+    table: 'input'
+    value_map:
+      Stop: 0x0002
+      Standby: 0x0008
+      Running: 0x0040
+  - pub_topic: "running_state"
+    address: 13000
+    table: 'input'
+    mask: 0x02
+  - pub_topic: "battery/charge"
+    address: 13022
+    table: 'input'
+    json_key: soc
+    scale: 0.1
+  - pub_topic: "battery/charge"
+    address: 13022
+    table: 'input'
+    json_key: percent
+  - pub_topic: "charge_discharge_power"
+    set_topic: "charge_discharge_power/set"
+    address: 13051
+`
+
+func TestFromModbus4MQTT(t *testing.T) {
+	requires := require.New(t)
+
+	res, err := convert.FromModbus4MQTT(strings.NewReader(modbus4mqtt))
+	requires.NoError(err)
+
+	requires.Len(res.Registers.Input, 4)
+	requires.Len(res.Registers.Holding, 1)
+
+	requires.Equal(13002, res.Registers.Input[0].Address)
+	requires.Equal("uint32", res.Registers.Input[1].Type)
+	requires.Equal(map[int]interface{}{2: "Stop", 8: "Standby", 0x40: "Running"}, res.Registers.Input[2].Values)
+	requires.Equal("battery_charge_soc", res.Registers.Input[3].Name)
+	requires.Equal(13052, res.Registers.Holding[0].Address)
+
+	requires.Len(res.Skipped, 2)
+	requires.Contains(res.Skipped[0].String(), "mask")
+	requires.Contains(res.Skipped[1].String(), "already translated")
+
+	// Sungrow's word order has to be asked for
+	res, err = convert.FromModbus4MQTT(strings.NewReader(strings.Replace(modbus4mqtt, "word_order: lowhigh", "", 1)))
+	requires.NoError(err)
+	requires.Len(res.Registers.Input, 3)
+}
+
+const protocolCSV = `Table 3-1 Running information variable address definition,,,,,
+No.,Name,Register address,Data type,Unit,Note
+1,Serial number,4990~4999,UTF-8,,
+2,Device type code,5000,U16,,
+3,Daily power yields,5003,U16,0.1kWh,
+4,Total power yields,5004-5005,U32,kWh,
+5,Internal temperature,5008,S16,0.1 ℃,
+6,Work state,5038,U16,,"0x0: Run
+0x8000: Stop
+0x1300: Key stop"
+7,Reserved,5039,,,
+8,Bad,50x,U16,,
+9,Power factor,5035-5036,S16,0.001,
+`
+
+func TestFromCSV(t *testing.T) {
+	requires := require.New(t)
+
+	res, err := convert.FromCSV(strings.NewReader(protocolCSV), false)
+	requires.NoError(err)
+
+	requires.Empty(res.Registers.Holding)
+	requires.Len(res.Registers.Input, 7)
+
+	byName := map[string]*sungrow.Register{}
+	for i, r := range res.Registers.Input {
+		byName[r.Name] = &res.Registers.Input[i]
+	}
+
+	requires.Equal(uint(10), byName["serial_number"].Count)
+	requires.Equal("string", byName["serial_number"].Type)
+	requires.Equal(0.1, byName["daily_power_yields"].Scale)
+	requires.Equal("kWh", byName["daily_power_yields"].GetUnit())
+	requires.Equal("uint32", byName["total_power_yields"].Type)
+	requires.Equal(1.0, byName["total_power_yields"].Scale)
+	requires.Equal("int16", byName["internal_temperature"].Type)
+	requires.Equal("℃", byName["internal_temperature"].GetUnit())
+	requires.Equal(map[int]interface{}{0: "Run", 0x8000: "Stop", 0x1300: "Key stop"}, byName["work_state"].Values)
+	requires.Equal("uint16", byName["reserved"].Type)
+
+	requires.Len(res.Skipped, 2)
+	requires.Contains(res.Skipped[0].String(), "line 10 Bad: address")
+	requires.Contains(res.Skipped[1].String(), "spans 2 registers")
+
+	_, err = convert.FromCSV(strings.NewReader("a,b\n1,2\n"), false)
+	requires.Error(err)
+}
+
+func TestWriteYAMLAndCompare(t *testing.T) {
+	requires := require.New(t)
+
+	res, err := convert.FromCSV(strings.NewReader(protocolCSV), false)
+	requires.NoError(err)
+	res.SetModels("SG5KTL-MT")
+
+	var buf bytes.Buffer
+	requires.NoError(convert.WriteYAML(&buf, res.Registers))
+	requires.Contains(buf.String(), `    - address: 5038
+      name: "work_state"
+      values:
+        0x0: "Run"
+        0x1300: "Key stop"
+        0x8000: "Stop"
+      models: ["SG5KTL-MT"]
+`)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(&buf))
+	requires.Equal(res.Registers.Input, inv.Registers.Input)
+
+	var defined sungrow.Inverter
+	requires.NoError(defined.Define(strings.NewReader(`registers:
+  input:
+    - address: 5003
+      name: "daily_power_yields"
+      unit: "kWh"
+      scale: 0.1
+    - address: 5004
+      name: "total_power_yields"
+      unit: "kWh"
+      scale: 0.1
+      type: "uint32"
+`)))
+
+	var diffs []string
+	for _, d := range convert.Compare(res.Registers, &defined, "SG5KTL-MT") {
+		diffs = append(diffs, d.String())
+	}
+	requires.Contains(diffs, "input 5004 total_power_yields: scale 1 not 0.1")
+	requires.Contains(diffs, "input 5038 work_state: not in the definition")
+	requires.NotContains(strings.Join(diffs, "\n"), "daily_power_yields")
+}
+
+const exportable = `registers:
+  input:
+    - address: 5003
+      name: "daily_power_yields"
+      unit: "kWh"
+      scale: 0.1
+    - address: 5031
+      name: "total_active_power"
+      unit: "W"
+      type: "uint32"
+    - address: 5038
+      name: "work_state"
+      values:
+        0x0: "Run"
+        0x8000: "Stop"
+    - address: 5081
+      name: "work_state"
+      type: "uint32"
+      models: ["SG5KTL-MT"]
+    - address: 4990
+      name: "serial_number"
+      type: "string"
+      count: 10
+    - address: 13022
+      name: "battery_level"
+      unit: "%"
+      scale: 0.1
+      models: ["SH10RT"]
+  holding:
+    - address: 5019
+      name: "power_limitation_setting"
+      unit: "%"
+      scale: 0.1
+`
+
+func TestHomeAssistantExport(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(exportable)))
+
+	var buf bytes.Buffer
+	ha := convert.HomeAssistant{Host: "10.0.0.5", Slave: 1, Prefix: "Sungrow "}
+	requires.NoError(ha.Write(&buf, &inv, "SG5KTL-MT"))
+
+	out := buf.String()
+	requires.Contains(out, `      - name: Sungrow Daily power yields
+        unique_id: sungrow_daily_power_yields
+        slave: 1
+        address: 5002
+        input_type: input
+        data_type: uint16
+        scale: 0.1
+        precision: 1
+        unit_of_measurement: kWh
+        device_class: energy
+        state_class: total_increasing
+`)
+	requires.Contains(out, "        data_type: uint32\n        swap: word\n        unit_of_measurement: W\n        device_class: power\n")
+	requires.Contains(out, "name: Sungrow Work state 5081\n")
+	requires.Contains(out, "input_type: holding")
+	requires.NotContains(out, "battery_level")
+	requires.Contains(out, `{{ {0: "Run", 32768: "Stop"}.get(states("sensor.sungrow_work_state")|int(-1), "Unknown") }}`)
+
+	// Importing it gives back what was exported
+	res, err := convert.FromHomeAssistant(&buf)
+	requires.NoError(err)
+	requires.Empty(res.Skipped)
+	requires.Len(res.Registers.Input, 5)
+	requires.Len(res.Registers.Holding, 1)
+
+	for i, want := range []sungrow.Register{inv.Registers.Input[0], inv.Registers.Input[1], inv.Registers.Input[2]} {
+		got := res.Registers.Input[i]
+		requires.Equal(want.Address, got.Address)
+		requires.Equal(want.Type, got.Type)
+		requires.Equal(want.Scale, got.Scale)
+		requires.Equal(want.Unit, got.Unit)
+		requires.Equal(want.Values, got.Values)
+	}
+	requires.Equal(uint(10), res.Registers.Input[4].Count)
+}
+
+func TestTemplates(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(exportable)))
+
+	templates := convert.Templates(&inv, "SH10RT")
+	requires.Len(templates, 6)
+
+	byName := map[string]convert.Template{}
+	for _, tmpl := range templates {
+		byName[tmpl.Name] = tmpl
+	}
+
+	power := byName["total_active_power"]
+	requires.Equal(5030, power.Register)
+	requires.Equal(2, power.Quantity)
+	requires.Equal("lowhigh", power.WordOrder)
+	requires.Equal(convert.EVCCRegister{Address: 5030, Type: "input", Decode: "uint32s"}, power.EVCC)
+	requires.Equal(convert.NodeREDRead{DataType: "InputRegister", Adr: 5030, Quantity: 2}, power.NodeRED)
+
+	requires.Equal(map[string]string{"0": "Run", "32768": "Stop"}, byName["work_state"].Values)
+	requires.Equal(10, byName["serial_number"].Quantity)
+	requires.Equal("HoldingRegister", byName["power_limitation_setting"].NodeRED.DataType)
+	requires.Equal(0.1, byName["battery_level"].Scale)
+
+	var buf bytes.Buffer
+	requires.NoError(convert.WriteTemplates(&buf, &inv, "SH10RT"))
+	requires.Contains(buf.String(), `"model": "SH10RT"`)
+}
@@ -0,0 +1,201 @@
+package convert
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CSV columns, found by the words in their heading
+var csvColumns = []struct {
+	column string
+	words  []string
+}{
+	{"address", []string{"address"}},
+	{"name", []string{"name", "signal"}},
+	{"type", []string{"data type", "type"}},
+	{"unit", []string{"unit"}},
+	{"bank", []string{"register type", "read/write", "access", "table"}},
+	{"note", []string{"note", "remark", "description", "range"}},
+}
+
+// FromCSV translates a CSV export of the tables in Sungrow's protocol
+// document. The columns are found by their headings, an address, name,
+// data type, unit with its scale such as "0.1 kWh", and a note holding any
+// table of values. Registers are input registers unless a register type
+// column says they're holding or read/write, or holding is set.
+func FromCSV(r io.Reader, holding bool) (*Result, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// The heading is the first row naming an address column
+	columns := map[string]int{}
+	start := -1
+	for i, row := range records {
+		if found := headings(row); found["address"] >= 0 && found["name"] >= 0 {
+			columns, start = found, i+1
+			break
+		}
+	}
+
+	if start < 0 {
+		return nil, fmt.Errorf("no heading with address and name columns")
+	}
+
+	cell := func(row []string, column string) string {
+		i := columns[column]
+		if i < 0 || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	res := &Result{}
+	for line, row := range records[start:] {
+		name := cell(row, "name")
+		address := cell(row, "address")
+		if name == "" && address == "" {
+			continue
+		}
+
+		entry := fmt.Sprintf("line %d %s", start+line+1, name)
+
+		first, last, err := addressRange(address)
+		if err != nil {
+			res.skip(entry, "address %q: %v", address, err)
+			continue
+		}
+
+		typ, size, isa := registerType(cell(row, "type"))
+		if !isa {
+			res.skip(entry, "data type %q has no equivalent", cell(row, "type"))
+			continue
+		}
+
+		reg := newRegister(first, snake(name))
+		reg.Type = typ
+
+		words := last - first + 1
+		switch {
+		case typ == "string":
+			reg.Count = uint(words)
+		case words != size:
+			res.skip(entry, "%s spans %d registers, not %d", typ, words, size)
+			continue
+		}
+
+		if scale, unit, err := parseUnit(cell(row, "unit")); err != nil {
+			res.skip(entry, "unit %q: %v", cell(row, "unit"), err)
+			continue
+		} else {
+			reg.Scale = scale
+			if unit != "" {
+				reg.Unit = &unit
+			}
+		}
+
+		if typ != "string" {
+			reg.Values = parseValues(cell(row, "note"))
+		}
+
+		bank := strings.ToLower(cell(row, "bank"))
+		isHolding := holding
+		switch {
+		case strings.Contains(bank, "hold"), strings.Contains(bank, "rw"), strings.Contains(bank, "write"):
+			isHolding = true
+		case strings.Contains(bank, "input"), strings.Contains(bank, "ro"), strings.Contains(bank, "read"):
+			isHolding = false
+		}
+
+		res.add(isHolding, reg)
+	}
+
+	return res, nil
+}
+
+func headings(row []string) map[string]int {
+	found := map[string]int{}
+	for _, c := range csvColumns {
+		found[c.column] = -1
+	}
+
+	for _, c := range csvColumns {
+	words:
+		for _, w := range c.words {
+			for i, heading := range row {
+				if !taken(found, i) && strings.Contains(strings.ToLower(heading), w) {
+					found[c.column] = i
+					break words
+				}
+			}
+		}
+	}
+
+	return found
+}
+
+func taken(found map[string]int, i int) bool {
+	for _, j := range found {
+		if j == i {
+			return true
+		}
+	}
+	return false
+}
+
+// addressRange reads "5004" or a range "5004-5005" or "5004~5005"
+func addressRange(s string) (int, int, error) {
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '-' || r == '~' || r == '–' })
+	if len(parts) == 0 || len(parts) > 2 {
+		return 0, 0, fmt.Errorf("not an address")
+	}
+
+	first, err := parseInt(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	last := first
+	if len(parts) == 2 {
+		if last, err = parseInt(parts[1]); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if first < 1 || last < first {
+		return 0, 0, fmt.Errorf("not an address")
+	}
+
+	return first, last, nil
+}
+
+var unitScale = regexp.MustCompile(`^([0-9]*\.?[0-9]+)\s*(.*)$`)
+
+// parseUnit splits a unit such as "0.1 kWh" into its scale and unit
+func parseUnit(s string) (float64, string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "-" || s == "/" {
+		return 1, "", nil
+	}
+
+	m := unitScale.FindStringSubmatch(s)
+	if m == nil {
+		return 1, s, nil
+	}
+
+	scale, err := strconv.ParseFloat(m[1], 64)
+	if err != nil || scale == 0 {
+		return 0, "", fmt.Errorf("bad scale")
+	}
+
+	return scale, strings.TrimSpace(m[2]), nil
+}
@@ -0,0 +1,348 @@
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/freman/sungrow"
+	"gopkg.in/yaml.v3"
+)
+
+// exported is a register of the model, named uniquely
+type exported struct {
+	sungrow.Register
+	holding bool
+	name    string
+}
+
+// forModel lists the input and holding registers of the model, later
+// registers with a name already taken get their address added to it
+func forModel(inv *sungrow.Inverter, model string) []exported {
+	var regs []exported
+	taken := map[string]bool{}
+
+	for _, bank := range []struct {
+		holding bool
+		regs    []sungrow.Register
+	}{{false, inv.Registers.Input}, {true, inv.Registers.Holding}} {
+		for _, r := range bank.regs {
+			if model != "" && !r.Models.ContainsOrNull(model) {
+				continue
+			}
+
+			name := r.Name
+			if taken[name] {
+				name = fmt.Sprintf("%s_%d", name, r.Address)
+			}
+			taken[name] = true
+
+			regs = append(regs, exported{Register: r, holding: bank.holding, name: name})
+		}
+	}
+
+	return regs
+}
+
+// words is how many 16 bit registers hold the value, registers other than
+// strings with a count are decoded from their first value
+func (e exported) words() int {
+	switch e.Type {
+	case "string":
+		return e.Size()
+	case "int32", "uint32":
+		return 2
+	}
+	return 1
+}
+
+func (e exported) bank() string {
+	if e.holding {
+		return "holding"
+	}
+	return "input"
+}
+
+// DeviceClass guesses the Home Assistant device class of a unit.
+func DeviceClass(unit string) string {
+	switch unit {
+	case "W", "kW":
+		return "power"
+	case "Wh", "kWh", "MWh":
+		return "energy"
+	case "V":
+		return "voltage"
+	case "A":
+		return "current"
+	case "Hz":
+		return "frequency"
+	case "°C", "℃":
+		return "temperature"
+	case "VA", "kVA":
+		return "apparent_power"
+	case "var", "Var", "kvar":
+		return "reactive_power"
+	case "h", "min", "s":
+		return "duration"
+	}
+	return ""
+}
+
+// HomeAssistant configures the Home Assistant export.
+type HomeAssistant struct {
+	// Hub name and where it connects
+	Hub  string
+	Host string
+	Port int
+	// Modbus unit id of the inverter
+	Slave int
+	// Put in front of the sensor names, and so their entity ids
+	Prefix string
+	// Seconds between reads, zero for Home Assistant's default
+	ScanInterval int
+}
+
+type haHubOut struct {
+	Name    string        `yaml:"name"`
+	Type    string        `yaml:"type"`
+	Host    string        `yaml:"host"`
+	Port    int           `yaml:"port"`
+	Sensors []haSensorOut `yaml:"sensors"`
+}
+
+type haSensorOut struct {
+	Name         string   `yaml:"name"`
+	UniqueID     string   `yaml:"unique_id"`
+	Slave        int      `yaml:"slave"`
+	Address      int      `yaml:"address"`
+	InputType    string   `yaml:"input_type"`
+	DataType     string   `yaml:"data_type"`
+	Count        int      `yaml:"count,omitempty"`
+	Swap         string   `yaml:"swap,omitempty"`
+	Scale        *float64 `yaml:"scale,omitempty"`
+	Precision    *int     `yaml:"precision,omitempty"`
+	Unit         string   `yaml:"unit_of_measurement,omitempty"`
+	DeviceClass  string   `yaml:"device_class,omitempty"`
+	StateClass   string   `yaml:"state_class,omitempty"`
+	ScanInterval int      `yaml:"scan_interval,omitempty"`
+}
+
+type haTemplateOut struct {
+	Name     string `yaml:"name"`
+	UniqueID string `yaml:"unique_id"`
+	State    string `yaml:"state"`
+}
+
+// Write writes a Home Assistant modbus configuration with a sensor for each
+// input and holding register of the model. Registers with a table of values
+// also get a template sensor with the labels. Computed registers are left out.
+func (ha HomeAssistant) Write(w io.Writer, inv *sungrow.Inverter, model string) error {
+	hub := haHubOut{Name: ha.Hub, Type: "tcp", Host: ha.Host, Port: ha.Port}
+	if hub.Name == "" {
+		hub.Name = "sungrow"
+	}
+	if hub.Port == 0 {
+		hub.Port = 502
+	}
+
+	var templates []haTemplateOut
+
+	for _, r := range forModel(inv, model) {
+		name := ha.Prefix + label(r.name)
+		id := snake(name)
+
+		s := haSensorOut{
+			Name:         name,
+			UniqueID:     id,
+			Slave:        ha.Slave,
+			Address:      r.Address - 1,
+			InputType:    r.bank(),
+			DataType:     r.Type,
+			Unit:         r.GetUnit(),
+			ScanInterval: ha.ScanInterval,
+		}
+
+		switch r.Type {
+		case "string":
+			s.Count = r.words()
+		case "int32", "uint32":
+			// Sungrow sends the low word first
+			s.Swap = "word"
+		}
+
+		if r.Type != "string" && r.Scale != 1 {
+			scale := r.Scale
+			precision := decimals(r.Scale)
+			s.Scale, s.Precision = &scale, &precision
+		}
+
+		if len(r.Values) == 0 && len(r.Bits) == 0 && r.Faults == "" {
+			s.DeviceClass = DeviceClass(s.Unit)
+			switch {
+			case s.DeviceClass == "energy":
+				s.StateClass = "total_increasing"
+			case s.DeviceClass != "":
+				s.StateClass = "measurement"
+			}
+		}
+
+		hub.Sensors = append(hub.Sensors, s)
+
+		if len(r.Values) > 0 {
+			templates = append(templates, haTemplateOut{
+				Name:     name + " label",
+				UniqueID: id + "_label",
+				State:    haLabels(r.Values, id),
+			})
+		}
+	}
+
+	config := map[string]interface{}{"modbus": []haHubOut{hub}}
+	if len(templates) > 0 {
+		config["template"] = []map[string]interface{}{{"sensor": templates}}
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(config); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// haLabels is a template looking the sensor's state up in the values
+func haLabels(values map[int]interface{}, entity string) string {
+	var pairs []string
+	for _, v := range sortedKeys(values) {
+		pairs = append(pairs, fmt.Sprintf("%d: %s", v, strconv.Quote(modelName(values[v]))))
+	}
+	return fmt.Sprintf(`{{ {%s}.get(states("sensor.%s")|int(-1), "Unknown") }}`, strings.Join(pairs, ", "), entity)
+}
+
+// modelName is the label of a value, device_type_code values are maps
+func modelName(v interface{}) string {
+	if m, isa := v.(map[string]interface{}); isa {
+		if name, isa := m["name"].(string); isa {
+			return name
+		}
+	}
+	return fmt.Sprint(v)
+}
+
+// label makes a sensor name of a register name
+func label(name string) string {
+	s := strings.ReplaceAll(name, "_", " ")
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// decimals is how many decimal places a scale gives
+func decimals(scale float64) int {
+	d := 0
+	for d < 6 && math.Abs(scale*math.Pow10(d)-math.Round(scale*math.Pow10(d))) > 1e-9 {
+		d++
+	}
+	return d
+}
+
+// Template is a register described for integrations such as Node-RED and
+// EVCC that are configured with JSON.
+type Template struct {
+	Name    string `json:"name"`
+	Bank    string `json:"bank"`
+	Address int    `json:"address"`
+	// Address on the wire, zero based
+	Register int    `json:"register"`
+	Type     string `json:"type"`
+	// 16 bit registers to read
+	Quantity    int               `json:"quantity"`
+	WordOrder   string            `json:"word_order,omitempty"`
+	Scale       float64           `json:"scale"`
+	Unit        string            `json:"unit,omitempty"`
+	DeviceClass string            `json:"device_class,omitempty"`
+	Values      map[string]string `json:"values,omitempty"`
+	Bits        map[string]string `json:"bits,omitempty"`
+	EVCC        EVCCRegister      `json:"evcc"`
+	NodeRED     NodeREDRead       `json:"nodered"`
+}
+
+// EVCCRegister is the register of an EVCC modbus plugin.
+type EVCCRegister struct {
+	Address int    `json:"address"`
+	Type    string `json:"type"`
+	Decode  string `json:"decode"`
+}
+
+// NodeREDRead is the settings of a Node-RED modbus read node.
+type NodeREDRead struct {
+	DataType string `json:"dataType"`
+	Adr      int    `json:"adr"`
+	Quantity int    `json:"quantity"`
+}
+
+// Templates describes the input and holding registers of the model.
+func Templates(inv *sungrow.Inverter, model string) []Template {
+	var templates []Template
+
+	for _, r := range forModel(inv, model) {
+		t := Template{
+			Name:        r.name,
+			Bank:        r.bank(),
+			Address:     r.Address,
+			Register:    r.Address - 1,
+			Type:        r.Type,
+			Quantity:    r.words(),
+			Scale:       r.Scale,
+			Unit:        r.GetUnit(),
+			DeviceClass: DeviceClass(r.GetUnit()),
+			EVCC:        EVCCRegister{Address: r.Address - 1, Type: r.bank(), Decode: r.Type},
+			NodeRED:     NodeREDRead{DataType: "InputRegister", Adr: r.Address - 1, Quantity: r.words()},
+		}
+
+		if r.holding {
+			t.NodeRED.DataType = "HoldingRegister"
+		}
+
+		switch r.Type {
+		case "int32", "uint32":
+			t.WordOrder = "lowhigh"
+			// EVCC's word swapped decodings
+			t.EVCC.Decode += "s"
+		case "string":
+			t.EVCC.Decode = "string"
+		}
+
+		if len(r.Values) > 0 {
+			t.Values = map[string]string{}
+			for v, l := range r.Values {
+				t.Values[strconv.Itoa(v)] = modelName(l)
+			}
+		}
+
+		if len(r.Bits) > 0 {
+			t.Bits = map[string]string{}
+			for mask, l := range r.Bits {
+				t.Bits[fmt.Sprintf("0x%X", mask)] = l
+			}
+		}
+
+		templates = append(templates, t)
+	}
+
+	return templates
+}
+
+// WriteTemplates writes the JSON description of the model's registers.
+func WriteTemplates(w io.Writer, inv *sungrow.Inverter, model string) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Model     string     `json:"model"`
+		Registers []Template `json:"registers"`
+	}{model, Templates(inv, model)})
+}
@@ -0,0 +1,182 @@
+package convert
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// haSensor is a sensor of Home Assistant's modbus integration
+type haSensor struct {
+	Name      string   `yaml:"name"`
+	UniqueID  string   `yaml:"unique_id"`
+	Address   *int     `yaml:"address"`
+	InputType string   `yaml:"input_type"`
+	DataType  string   `yaml:"data_type"`
+	Swap      string   `yaml:"swap"`
+	Count     int      `yaml:"count"`
+	Scale     *float64 `yaml:"scale"`
+	Offset    float64  `yaml:"offset"`
+	Structure string   `yaml:"structure"`
+	Unit      string   `yaml:"unit_of_measurement"`
+}
+
+type haHub struct {
+	Name    string     `yaml:"name"`
+	Sensors []haSensor `yaml:"sensors"`
+}
+
+// haTemplate is a template sensor, they hold the enum tables
+type haTemplate struct {
+	Name  string `yaml:"name"`
+	State string `yaml:"state"`
+}
+
+// FromHomeAssistant translates the sensors of a Home Assistant modbus
+// configuration. It reads a whole configuration with a modbus key, or just
+// the list of hubs from a package or !include. Enum tables are taken from
+// template sensors that map a modbus sensor's state through a dictionary.
+func FromHomeAssistant(r io.Reader) (*Result, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var hubs []haHub
+	var templates []haTemplate
+
+	var config struct {
+		Modbus   []haHub `yaml:"modbus"`
+		Template []struct {
+			Sensor []haTemplate `yaml:"sensor"`
+		} `yaml:"template"`
+	}
+
+	if err := yaml.Unmarshal(b, &config); err == nil && config.Modbus != nil {
+		hubs = config.Modbus
+		for _, t := range config.Template {
+			templates = append(templates, t.Sensor...)
+		}
+	} else if err := yaml.Unmarshal(b, &hubs); err != nil {
+		return nil, fmt.Errorf("not a Home Assistant modbus configuration: %w", err)
+	}
+
+	res := &Result{}
+	bySensor := map[string]sensorRef{}
+
+	for _, hub := range hubs {
+		for _, s := range hub.Sensors {
+			entry := s.Name
+			if entry == "" {
+				entry = s.UniqueID
+			}
+
+			if s.Address == nil {
+				res.skip(entry, "no address")
+				continue
+			}
+
+			holding := false
+			switch s.InputType {
+			case "", "holding":
+				// Holding is Home Assistant's default
+				holding = true
+			case "input":
+			default:
+				res.skip(entry, "input_type %s isn't a register", s.InputType)
+				continue
+			}
+
+			if s.Structure != "" || s.DataType == "custom" {
+				res.skip(entry, "custom structure %q", s.Structure)
+				continue
+			}
+
+			typ, size, isa := registerType(s.DataType)
+			if !isa {
+				res.skip(entry, "data_type %s has no equivalent", s.DataType)
+				continue
+			}
+
+			if s.Offset != 0 {
+				res.skip(entry, "offset %v has no equivalent", s.Offset)
+				continue
+			}
+
+			reg := newRegister(*s.Address+1, snake(s.Name))
+			reg.Type = typ
+
+			switch {
+			case typ == "string":
+				reg.Count = uint(s.Count)
+				if reg.Count == 0 {
+					reg.Count = 1
+				}
+			case size == 2 && s.Swap != "word":
+				// Sungrow sends the low word first, Home Assistant needs to swap them
+				res.skip(entry, "32 bit value with the high word first, swap is %q", s.Swap)
+				continue
+			case size == 1 && s.Swap != "" && s.Swap != "none":
+				res.skip(entry, "swap %s has no equivalent", s.Swap)
+				continue
+			}
+
+			if s.Scale != nil {
+				reg.Scale = *s.Scale
+			}
+			if s.Unit != "" {
+				unit := s.Unit
+				reg.Unit = &unit
+			}
+
+			res.add(holding, reg)
+
+			ref := sensorRef{holding, len(res.Registers.Input) - 1}
+			if holding {
+				ref.index = len(res.Registers.Holding) - 1
+			}
+			for _, id := range []string{s.UniqueID, snake(s.Name)} {
+				if id != "" {
+					bySensor[id] = ref
+				}
+			}
+		}
+	}
+
+	for _, t := range templates {
+		m := haStateRef.FindStringSubmatch(t.State)
+		if m == nil {
+			continue
+		}
+
+		ref, isa := bySensor[m[1]]
+		if !isa {
+			continue
+		}
+
+		values := parseValues(t.State)
+		if len(values) == 0 {
+			res.skip(t.Name, "couldn't find a table of values in the template")
+			continue
+		}
+
+		regs := res.Registers.Input
+		if ref.holding {
+			regs = res.Registers.Holding
+		}
+		regs[ref.index].Values = values
+	}
+
+	return res, nil
+}
+
+// sensorRef is where a translated sensor went
+type sensorRef struct {
+	holding bool
+	index   int
+}
+
+// haStateRef finds the sensor a template reads
+var haStateRef = regexp.MustCompile(`states\(\s*['"]sensor\.([a-z0-9_]+)['"]\s*\)`)
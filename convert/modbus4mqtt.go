@@ -0,0 +1,124 @@
+package convert
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type m4mRegister struct {
+	PubTopic string                 `yaml:"pub_topic"`
+	SetTopic string                 `yaml:"set_topic"`
+	Address  *int                   `yaml:"address"`
+	Table    string                 `yaml:"table"`
+	Type     string                 `yaml:"type"`
+	Scale    *float64               `yaml:"scale"`
+	Mask     *int                   `yaml:"mask"`
+	ValueMap map[string]interface{} `yaml:"value_map"`
+	JSONKey  string                 `yaml:"json_key"`
+}
+
+// FromModbus4MQTT translates the registers of a modbus4mqtt configuration.
+// Registers are named after their topic, with a json_key appended.
+func FromModbus4MQTT(r io.Reader) (*Result, error) {
+	var config struct {
+		AddressOffset int           `yaml:"address_offset"`
+		WordOrder     string        `yaml:"word_order"`
+		Registers     []m4mRegister `yaml:"registers"`
+	}
+
+	if err := yaml.NewDecoder(r).Decode(&config); err != nil {
+		return nil, fmt.Errorf("not a modbus4mqtt configuration: %w", err)
+	}
+
+	res := &Result{}
+	seen := map[string]bool{}
+
+	for _, m := range config.Registers {
+		topic := m.PubTopic
+		if topic == "" {
+			topic = m.SetTopic
+		}
+
+		entry := topic
+		name := snake(topic)
+		if m.JSONKey != "" {
+			entry += " " + m.JSONKey
+			name += "_" + snake(m.JSONKey)
+		}
+
+		if m.Address == nil {
+			res.skip(entry, "no address")
+			continue
+		}
+
+		holding := false
+		switch m.Table {
+		case "", "holding":
+			// Holding is modbus4mqtt's default
+			holding = true
+		case "input":
+		default:
+			res.skip(entry, "table %s isn't a register", m.Table)
+			continue
+		}
+
+		if m.Mask != nil {
+			res.skip(entry, "mask 0x%X has no equivalent, use bits for flags", *m.Mask)
+			continue
+		}
+
+		typ, size, isa := registerType(m.Type)
+		if !isa || typ == "string" {
+			res.skip(entry, "type %s has no equivalent", m.Type)
+			continue
+		}
+
+		if size == 2 && config.WordOrder != "lowhigh" {
+			// Sungrow sends the low word first
+			res.skip(entry, "32 bit value with the high word first, word_order is %q", config.WordOrder)
+			continue
+		}
+
+		// Several topics can be pulled from the one register with json keys
+		key := fmt.Sprintf("%t/%d", holding, *m.Address)
+		if seen[key] {
+			res.skip(entry, "register %d is already translated", *m.Address+config.AddressOffset+1)
+			continue
+		}
+		seen[key] = true
+
+		reg := newRegister(*m.Address+config.AddressOffset+1, name)
+		reg.Type = typ
+		if m.Scale != nil {
+			reg.Scale = *m.Scale
+		}
+
+		if len(m.ValueMap) > 0 {
+			reg.Values = map[int]interface{}{}
+
+			// value_map maps labels to values
+			labels := make([]string, 0, len(m.ValueMap))
+			for label := range m.ValueMap {
+				labels = append(labels, label)
+			}
+			sort.Strings(labels)
+
+			for _, label := range labels {
+				v, err := parseInt(strings.TrimSpace(fmt.Sprint(m.ValueMap[label])))
+				if err != nil {
+					res.skip(entry, "value %v of %q isn't a number", m.ValueMap[label], label)
+					continue
+				}
+				reg.Values[v] = label
+			}
+		}
+
+		res.add(holding, reg)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,278 @@
+// Package discovery finds Sungrow inverters and WiNet-S dongles on the local network.
+package discovery
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/transport"
+	"github.com/goburrow/modbus"
+)
+
+// identity is used to confirm a modbus device when no definition is provided
+const identity = `registers:
+  input:
+    - address: 4990
+      name: "serial_number"
+      count: 10
+      type: "string"
+    - address: 5000
+      name: "device_type_code"
+`
+
+// Options controls how hosts are probed.
+type Options struct {
+	ModbusPort int
+	WSPort     int
+	SlaveID    byte
+	// Connect & Read timeout for each probe
+	Timeout time.Duration
+	// How many hosts to probe at once
+	Concurrency int
+	// Definition used to name the device type, optional
+	Inverter *sungrow.Inverter
+}
+
+// DefaultOptions returns the options for a typical installation.
+func DefaultOptions() Options {
+	return Options{
+		ModbusPort:  502,
+		WSPort:      8082,
+		SlaveID:     1,
+		Timeout:     2 * time.Second,
+		Concurrency: 64,
+	}
+}
+
+// Device is a confirmed inverter or dongle.
+type Device struct {
+	Host           string                  `json:"host"`
+	Model          string                  `json:"model,omitempty"`
+	DeviceTypeCode int                     `json:"device_type_code,omitempty"`
+	Serial         string                  `json:"serial,omitempty"`
+	Transports     []string                `json:"transports"`
+	Attached       []transport.WinetDevice `json:"attached,omitempty"`
+}
+
+// Scan probes every host in the subnet, eg 192.168.1.0/24, and returns the
+// devices found ordered by address.
+func Scan(ctx context.Context, subnet string, opts Options) ([]Device, error) {
+	hosts, err := Hosts(subnet)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+
+	var mu sync.Mutex
+	var devices []Device
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, opts.Concurrency)
+
+	for _, host := range hosts {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return devices, ctx.Err()
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(host string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if d := Probe(ctx, host, opts); d != nil {
+				mu.Lock()
+				devices = append(devices, *d)
+				mu.Unlock()
+			}
+		}(host)
+	}
+
+	wg.Wait()
+
+	sort.Slice(devices, func(i, j int) bool {
+		return compareIP(devices[i].Host, devices[j].Host) < 0
+	})
+
+	return devices, nil
+}
+
+// Probe checks a single host for Modbus TCP and the WiNet-S websocket,
+// returning nil if neither is confirmed.
+func Probe(ctx context.Context, host string, opts Options) *Device {
+	d := &Device{Host: host}
+
+	if portOpen(ctx, host, opts.ModbusPort, opts.Timeout) {
+		if err := probeModbus(host, opts, d); err == nil {
+			d.Transports = append(d.Transports, "tcp")
+		}
+	}
+
+	if portOpen(ctx, host, opts.WSPort, opts.Timeout) {
+		if err := probeWinet(host, opts, d); err == nil {
+			d.Transports = append(d.Transports, "winet")
+		}
+	}
+
+	if len(d.Transports) == 0 {
+		return nil
+	}
+
+	return d
+}
+
+func probeModbus(host string, opts Options, d *Device) error {
+	handler := transport.NewBorkedTCPClient(net.JoinHostPort(host, strconv.Itoa(opts.ModbusPort)))
+	handler.SlaveID = opts.SlaveID
+	handler.Timeout = opts.Timeout
+	defer handler.Close()
+
+	var inv *sungrow.Inverter
+	if opts.Inverter != nil {
+		inv = opts.Inverter.Clone()
+	} else {
+		inv = &sungrow.Inverter{}
+		if err := inv.Define(strings.NewReader(identity)); err != nil {
+			return err
+		}
+	}
+
+	err := inv.ReadWithSkip(modbus.NewClient(handler), func(r sungrow.Register, funcCode int) bool {
+		return funcCode != modbus.FuncCodeReadInputRegisters || (r.Name != "serial_number" && r.Name != "device_type_code")
+	})
+	if err != nil {
+		return err
+	}
+
+	confirmed := false
+	for _, r := range inv.Registers.Input {
+		if !r.Supported || r.Err != nil {
+			continue
+		}
+
+		switch r.Name {
+		case "serial_number":
+			if s, isa := r.Value.(string); isa {
+				d.Serial = s
+				confirmed = true
+			}
+		case "device_type_code":
+			if len(r.RAW) == 2 {
+				d.DeviceTypeCode = int(binary.BigEndian.Uint16(r.RAW))
+				confirmed = true
+			}
+		}
+	}
+
+	if !confirmed {
+		return fmt.Errorf("%s does not look like a sungrow inverter", host)
+	}
+
+	d.Model = inv.Model()
+
+	return nil
+}
+
+func probeWinet(host string, opts Options, d *Device) error {
+	client := transport.NewWebsocketClient(host)
+	client.WSPort = opts.WSPort
+	client.Timeout = opts.Timeout
+	defer client.Close()
+
+	if err := client.Connect(); err != nil {
+		return err
+	}
+
+	d.Attached = client.Devices()
+	if len(d.Attached) > 0 {
+		if d.Model == "" {
+			d.Model = d.Attached[0].Model
+		}
+		if d.Serial == "" {
+			d.Serial = d.Attached[0].Serial
+		}
+	}
+
+	return nil
+}
+
+func portOpen(ctx context.Context, host string, port int, timeout time.Duration) bool {
+	if port <= 0 {
+		return false
+	}
+
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+	if err != nil {
+		return false
+	}
+
+	conn.Close()
+	return true
+}
+
+// Hosts expands a subnet into its host addresses, a bare address is returned as is.
+func Hosts(subnet string) ([]string, error) {
+	if !strings.Contains(subnet, "/") {
+		if net.ParseIP(subnet) == nil {
+			return nil, fmt.Errorf("invalid address %q", subnet)
+		}
+		return []string{subnet}, nil
+	}
+
+	ip, ipnet, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return nil, err
+	}
+
+	ip = ip.To4()
+	if ip == nil {
+		return nil, fmt.Errorf("only ipv4 subnets can be scanned, not %q", subnet)
+	}
+
+	ones, bits := ipnet.Mask.Size()
+	if bits-ones > 16 {
+		return nil, fmt.Errorf("subnet %q is too large to scan", subnet)
+	}
+
+	start := binary.BigEndian.Uint32(ipnet.IP.To4())
+	size := uint32(1) << uint(bits-ones)
+
+	var hosts []string
+	for n := uint32(0); n < size; n++ {
+		// Skip the network and broadcast addresses where they exist
+		if size > 2 && (n == 0 || n == size-1) {
+			continue
+		}
+
+		addr := make(net.IP, 4)
+		binary.BigEndian.PutUint32(addr, start+n)
+		hosts = append(hosts, addr.String())
+	}
+
+	return hosts, nil
+}
+
+func compareIP(a, b string) int {
+	ipA, ipB := net.ParseIP(a).To16(), net.ParseIP(b).To16()
+	for i := range ipA {
+		if ipA[i] != ipB[i] {
+			return int(ipA[i]) - int(ipB[i])
+		}
+	}
+	return 0
+}
@@ -0,0 +1,185 @@
+package discovery_test
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/discovery"
+	"github.com/freman/sungrow/proxy"
+	"github.com/freman/sungrow/transport"
+	"github.com/goburrow/modbus"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// inverter answers input register reads from a map, standing in for the real thing.
+type inverter map[uint16]uint16
+
+func (f inverter) Encode(pdu *modbus.ProtocolDataUnit) ([]byte, error) {
+	return append([]byte{pdu.FunctionCode}, pdu.Data...), nil
+}
+
+func (f inverter) Verify(aduRequest, aduResponse []byte) error {
+	return nil
+}
+
+func (f inverter) Decode(adu []byte) (*modbus.ProtocolDataUnit, error) {
+	return &modbus.ProtocolDataUnit{FunctionCode: adu[0], Data: adu[1:]}, nil
+}
+
+func (f inverter) Send(aduRequest []byte) ([]byte, error) {
+	address := binary.BigEndian.Uint16(aduRequest[1:])
+	quantity := binary.BigEndian.Uint16(aduRequest[3:])
+
+	resp := []byte{aduRequest[0], byte(quantity * 2)}
+	for i := uint16(0); i < quantity; i++ {
+		v, isa := f[address+i]
+		if !isa {
+			return []byte{aduRequest[0] | 0x80, modbus.ExceptionCodeIllegalDataAddress}, nil
+		}
+		resp = append(resp, byte(v>>8), byte(v))
+	}
+
+	return resp, nil
+}
+
+func serial(s string, at uint16) inverter {
+	regs := inverter{}
+	b := make([]byte, 20)
+	copy(b, s)
+	for i := 0; i < 10; i++ {
+		regs[at+uint16(i)] = binary.BigEndian.Uint16(b[i*2:])
+	}
+	return regs
+}
+
+func port(t *testing.T, addr net.Addr) int {
+	_, p, err := net.SplitHostPort(addr.String())
+	require.NoError(t, err)
+	i, err := strconv.Atoi(p)
+	require.NoError(t, err)
+	return i
+}
+
+func TestScan(t *testing.T) {
+	requires := require.New(t)
+
+	regs := serial("A2212345678", 4989)
+	regs[4999] = 0xE03
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	requires.NoError(err)
+	server := proxy.NewServer(regs)
+	go server.Serve(l)
+	defer server.Close()
+
+	ws := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		requires.NoError(err)
+		defer c.Close()
+
+		for _, response := range []string{
+			`{"result_code": 1, "result_msg": "success", "result_data": {"service": "connect", "token": "abc"}}`,
+			`{"result_code": 1, "result_msg": "success", "result_data": {"service": "devicelist", "list": [
+				{"dev_id": 1, "dev_code": 3587, "dev_type": 35, "dev_sn": "A2212345678", "dev_name": "SH10RT(COM1-001)", "dev_model": "SH10RT"}
+			], "count": 1}}`,
+		} {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+			requires.NoError(c.WriteMessage(websocket.TextMessage, []byte(response)))
+		}
+	}))
+	defer ws.Close()
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(`registers:
+  input:
+    - address: 4990
+      name: "serial_number"
+      count: 10
+      type: "string"
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0xE03:
+          hybrid: true
+          name: "SH10RT"
+`)))
+
+	opts := discovery.DefaultOptions()
+	opts.ModbusPort = port(t, l.Addr())
+	opts.WSPort = port(t, ws.Listener.Addr())
+	opts.Timeout = time.Second
+	opts.Inverter = &inv
+
+	devices, err := discovery.Scan(context.Background(), "127.0.0.1", opts)
+	requires.NoError(err)
+	requires.Len(devices, 1)
+
+	d := devices[0]
+	requires.Equal("127.0.0.1", d.Host)
+	requires.Equal("SH10RT", d.Model)
+	requires.Equal(0xE03, d.DeviceTypeCode)
+	requires.Equal("A2212345678", d.Serial)
+	requires.Equal([]string{"tcp", "winet"}, d.Transports)
+	requires.Len(d.Attached, 1)
+	requires.Equal("SH10RT(COM1-001)", d.Attached[0].Name)
+
+	// Without a definition the device type code is all we know
+	opts.Inverter = nil
+	opts.WSPort = 0
+	devices, err = discovery.Scan(context.Background(), "127.0.0.1/32", opts)
+	requires.NoError(err)
+	requires.Len(devices, 1)
+	requires.Empty(devices[0].Model)
+	requires.Equal(0xE03, devices[0].DeviceTypeCode)
+	requires.Equal([]string{"tcp"}, devices[0].Transports)
+}
+
+func TestHosts(t *testing.T) {
+	requires := require.New(t)
+
+	hosts, err := discovery.Hosts("192.168.1.0/30")
+	requires.NoError(err)
+	requires.Equal([]string{"192.168.1.1", "192.168.1.2"}, hosts)
+
+	hosts, err = discovery.Hosts("192.168.1.7/24")
+	requires.NoError(err)
+	requires.Len(hosts, 254)
+
+	hosts, err = discovery.Hosts("10.0.0.84")
+	requires.NoError(err)
+	requires.Equal([]string{"10.0.0.84"}, hosts)
+
+	_, err = discovery.Hosts("10.0.0.0/8")
+	requires.Error(err)
+
+	_, err = discovery.Hosts("inverter")
+	requires.Error(err)
+}
+
+func TestDeviceJSON(t *testing.T) {
+	requires := require.New(t)
+
+	b, err := json.Marshal(discovery.Device{
+		Host:       "10.0.0.84",
+		Transports: []string{"websocket"},
+		Attached:   []transport.WinetDevice{{ID: 1, Code: 3599, Type: 35, Serial: "A2201234567", Model: "SH10RT"}},
+	})
+	requires.NoError(err)
+	requires.JSONEq(`{
+		"host": "10.0.0.84",
+		"transports": ["websocket"],
+		"attached": [{"id": 1, "code": 3599, "type": 35, "serial": "A2201234567", "model": "SH10RT"}]
+	}`, string(b))
+}
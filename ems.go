@@ -0,0 +1,15 @@
+package sungrow
+
+// Values of the ems_mode_selection holding register
+const (
+	EMSModeSelfConsumption = 0
+	EMSModeForced          = 2
+	EMSModeExternal        = 3
+)
+
+// Values of the charge_discharge_command holding register
+const (
+	CommandCharge    = 0xAA
+	CommandDischarge = 0xBB
+	CommandStop      = 0xCC
+)
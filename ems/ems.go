@@ -0,0 +1,307 @@
+// Package ems acts as an external energy management system for hybrid
+// inverters, keeping the heartbeat alive and applying power setpoints.
+package ems
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/freman/sungrow"
+)
+
+const (
+	defaultTimeout     = 10 * time.Second
+	defaultMaxFailures = 3
+
+	// MinTimeout and MaxTimeout bound Controller.Timeout, the heartbeat is
+	// written in whole seconds and external_ems_heartbeat holds at most 20
+	MinTimeout = time.Second
+	MaxTimeout = 20 * time.Second
+)
+
+// ErrLostContact is returned when the inverter stops accepting writes.
+var ErrLostContact = errors.New("lost contact with the inverter")
+
+// Setpoint is the battery power wanted in W, positive charges, negative
+// discharges and zero idles.
+type Setpoint struct {
+	Power float64 `json:"power"`
+}
+
+// Status is what the controller is doing.
+type Status struct {
+	External      bool      `json:"external"`
+	Setpoint      *Setpoint `json:"setpoint,omitempty"`
+	LastSetpoint  time.Time `json:"last_setpoint,omitempty"`
+	LastHeartbeat time.Time `json:"last_heartbeat,omitempty"`
+}
+
+// Controller holds the inverter in External EMS mode while it runs.
+type Controller struct {
+	Inverter *sungrow.Inverter
+	Client   sungrow.ModbusWriter
+	// The inverter gives up on the EMS when it hasn't heard from it for this
+	// long, the heartbeat is written at a third of it. Between MinTimeout and
+	// MaxTimeout.
+	Timeout time.Duration
+	// Revert to self-consumption when no setpoint arrives for this long, zero never expires
+	SetpointTTL time.Duration
+	// Consecutive failed writes before giving up
+	MaxFailures int
+	Logger      *log.Logger
+
+	setpoints chan Setpoint
+
+	mu       sync.Mutex
+	status   Status
+	failures int
+}
+
+// NewController allocates a new Controller.
+func NewController(inv *sungrow.Inverter, client sungrow.ModbusWriter) *Controller {
+	return &Controller{
+		Inverter:    inv,
+		Client:      client,
+		Timeout:     defaultTimeout,
+		MaxFailures: defaultMaxFailures,
+		setpoints:   make(chan Setpoint, 1),
+	}
+}
+
+// Setpoints returns the channel setpoints are taken from.
+func (c *Controller) Setpoints() chan<- Setpoint {
+	return c.setpoints
+}
+
+// Set hands the controller a new setpoint, replacing any not yet applied.
+func (c *Controller) Set(sp Setpoint) {
+	for {
+		select {
+		case c.setpoints <- sp:
+			return
+		default:
+		}
+
+		// Drop the stale one
+		select {
+		case <-c.setpoints:
+		default:
+		}
+	}
+}
+
+// Status returns a snapshot of the controller's state.
+func (c *Controller) Status() Status {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := c.status
+	if s.Setpoint != nil {
+		sp := *s.Setpoint
+		s.Setpoint = &sp
+	}
+	return s
+}
+
+// Run keeps the heartbeat alive and applies setpoints until the context is
+// done or contact is lost, reverting to self-consumption either way.
+func (c *Controller) Run(ctx context.Context) error {
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	if timeout < MinTimeout || timeout > MaxTimeout {
+		return fmt.Errorf("timeout %s is outside %s to %s", timeout, MinTimeout, MaxTimeout)
+	}
+
+	err := c.Inverter.ReadWithSkip(c.Client, func(r sungrow.Register, funcCode int) bool {
+		return r.Name != "device_type_code"
+	})
+	if err != nil {
+		return err
+	}
+
+	if c.Inverter.Model() != "" && !c.Inverter.Hybrid() {
+		return fmt.Errorf("%s is not a hybrid inverter", c.Inverter.Model())
+	}
+
+	// Definitions may hold the heartbeat to less
+	if r := c.Inverter.Holding("external_ems_heartbeat"); r != nil {
+		if _, err := r.Encode(heartbeatSeconds(timeout)); err != nil {
+			return fmt.Errorf("timeout %s: %w", timeout, err)
+		}
+	}
+
+	heartbeat := time.NewTicker(timeout / 3)
+	defer heartbeat.Stop()
+
+	// Checks the setpoint hasn't expired
+	expiry := time.NewTicker(time.Second)
+	defer expiry.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.logf("ems: shutting down, reverting to self-consumption")
+			return c.selfConsumption()
+		case sp := <-c.setpoints:
+			c.check(c.apply(sp, timeout))
+		case <-heartbeat.C:
+			if c.Status().External {
+				c.check(c.heartbeat(timeout))
+			}
+		case now := <-expiry.C:
+			s := c.Status()
+			if s.External && c.SetpointTTL > 0 && now.Sub(s.LastSetpoint) > c.SetpointTTL {
+				c.logf("ems: no setpoint for %s, reverting to self-consumption", c.SetpointTTL)
+				c.check(c.selfConsumption())
+			}
+		}
+
+		if c.lost() {
+			c.logf("ems: writes keep failing, reverting to self-consumption")
+			if err := c.selfConsumption(); err != nil {
+				return fmt.Errorf("%w, and failed to revert: %v", ErrLostContact, err)
+			}
+			return ErrLostContact
+		}
+	}
+}
+
+func (c *Controller) apply(sp Setpoint, timeout time.Duration) error {
+	c.mu.Lock()
+	c.status.LastSetpoint = time.Now()
+	c.mu.Unlock()
+
+	command := sungrow.CommandStop
+	switch {
+	case sp.Power > 0:
+		command = sungrow.CommandCharge
+	case sp.Power < 0:
+		command = sungrow.CommandDischarge
+	}
+
+	c.logf("ems: setpoint %.0fW", sp.Power)
+
+	// Beat first so the inverter doesn't time out the moment it's in external mode
+	if err := c.heartbeat(timeout); err != nil {
+		return err
+	}
+
+	if command != sungrow.CommandStop {
+		if err := c.Inverter.Write(c.Client, "charge_discharge_power", math.Abs(sp.Power)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Inverter.Write(c.Client, "charge_discharge_command", command); err != nil {
+		return err
+	}
+
+	if err := c.Inverter.Write(c.Client, "ems_mode_selection", sungrow.EMSModeExternal); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.status.External = true
+	c.status.Setpoint = &sp
+	c.mu.Unlock()
+
+	return nil
+}
+
+func (c *Controller) heartbeat(timeout time.Duration) error {
+	if err := c.Inverter.Write(c.Client, "external_ems_heartbeat", heartbeatSeconds(timeout)); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.status.LastHeartbeat = time.Now()
+	c.mu.Unlock()
+
+	return nil
+}
+
+func heartbeatSeconds(timeout time.Duration) float64 {
+	return math.Max(1, math.Round(timeout.Seconds()))
+}
+
+func (c *Controller) selfConsumption() error {
+	c.mu.Lock()
+	c.status.External = false
+	c.status.Setpoint = nil
+	c.mu.Unlock()
+
+	if err := c.Inverter.Write(c.Client, "ems_mode_selection", sungrow.EMSModeSelfConsumption); err != nil {
+		return err
+	}
+
+	return c.Inverter.Write(c.Client, "charge_discharge_command", sungrow.CommandStop)
+}
+
+// check counts consecutive failures
+func (c *Controller) check(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err == nil {
+		c.failures = 0
+		return
+	}
+
+	c.failures++
+	if c.Logger != nil {
+		c.Logger.Printf("ems: %v", err)
+	}
+}
+
+func (c *Controller) lost() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	limit := c.MaxFailures
+	if limit <= 0 {
+		limit = defaultMaxFailures
+	}
+
+	return c.failures >= limit
+}
+
+func (c *Controller) logf(format string, v ...interface{}) {
+	if c.Logger != nil {
+		c.Logger.Printf(format, v...)
+	}
+}
+
+// ServeHTTP returns the status on GET and takes a setpoint, eg {"power": -2000},
+// on POST or PUT.
+func (c *Controller) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status := http.StatusOK
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost, http.MethodPut:
+		var sp Setpoint
+		if err := json.NewDecoder(r.Body).Decode(&sp); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.Set(sp)
+		status = http.StatusAccepted
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(c.Status())
+}
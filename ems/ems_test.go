@@ -0,0 +1,209 @@
+package ems_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/ems"
+	"github.com/goburrow/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+const registers = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0xE03:
+          hybrid: true
+          name: "SH10RT"
+  holding:
+    - address: 13050
+      name: "ems_mode_selection"
+    - address: 13051
+      name: "charge_discharge_command"
+    - address: 13052
+      name: "charge_discharge_power"
+    - address: 13080
+      name: "external_ems_heartbeat"
+      min: 0
+      max: 20
+`
+
+type fakeInverter struct {
+	mu         sync.Mutex
+	holding    map[uint16]uint16
+	heartbeats int
+	broken     bool
+}
+
+func newFakeInverter() *fakeInverter {
+	return &fakeInverter{holding: map[uint16]uint16{13049: 0, 13050: 0xCC, 13051: 0, 13079: 0}}
+}
+
+func (f *fakeInverter) get(address uint16) uint16 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.holding[address]
+}
+
+func (f *fakeInverter) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	if address == 4999 {
+		return []byte{0x0E, 0x03}, nil
+	}
+	return nil, &modbus.ModbusError{FunctionCode: 0x84, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}
+}
+
+func (f *fakeInverter) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.broken {
+		return nil, errors.New("timeout")
+	}
+
+	v := f.holding[address]
+	return []byte{byte(v >> 8), byte(v)}, nil
+}
+
+func (f *fakeInverter) WriteSingleRegister(address, value uint16) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.broken {
+		return nil, errors.New("timeout")
+	}
+
+	if address == 13079 {
+		f.heartbeats++
+	}
+	f.holding[address] = value
+	return nil, nil
+}
+
+func (f *fakeInverter) WriteMultipleRegisters(address, quantity uint16, value []byte) ([]byte, error) {
+	panic("not used")
+}
+
+func newController(t *testing.T, client *fakeInverter) *ems.Controller {
+	var inv sungrow.Inverter
+	require.NoError(t, inv.Define(strings.NewReader(registers)))
+
+	c := ems.NewController(&inv, client)
+	c.Timeout = 1500 * time.Millisecond
+	return c
+}
+
+func TestController(t *testing.T) {
+	requires := require.New(t)
+
+	client := newFakeInverter()
+	c := newController(t, client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- c.Run(ctx) }()
+
+	c.Setpoints() <- ems.Setpoint{Power: -2000}
+	requires.Eventually(func() bool { return client.get(13049) == sungrow.EMSModeExternal }, time.Second, 10*time.Millisecond)
+	requires.Equal(uint16(sungrow.CommandDischarge), client.get(13050))
+	requires.Equal(uint16(2000), client.get(13051))
+	requires.Equal(uint16(2), client.get(13079))
+
+	srv := httptest.NewServer(c)
+	defer srv.Close()
+
+	resp, err := http.Post(srv.URL, "application/json", strings.NewReader(`{"power": 1500}`))
+	requires.NoError(err)
+	resp.Body.Close()
+	requires.Equal(http.StatusAccepted, resp.StatusCode)
+
+	requires.Eventually(func() bool { return client.get(13050) == sungrow.CommandCharge }, time.Second, 10*time.Millisecond)
+	requires.Equal(uint16(1500), client.get(13051))
+	requires.Equal(1500.0, c.Status().Setpoint.Power)
+
+	// The heartbeat keeps going without setpoints
+	requires.Eventually(func() bool {
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		return client.heartbeats >= 4
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	requires.NoError(<-done)
+	requires.Equal(uint16(sungrow.EMSModeSelfConsumption), client.get(13049))
+	requires.Equal(uint16(sungrow.CommandStop), client.get(13050))
+	requires.False(c.Status().External)
+}
+
+func TestControllerSetpointExpiry(t *testing.T) {
+	requires := require.New(t)
+
+	client := newFakeInverter()
+	c := newController(t, client)
+	c.SetpointTTL = 500 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Run(ctx)
+
+	c.Set(ems.Setpoint{Power: 1000})
+	requires.Eventually(func() bool { return client.get(13049) == sungrow.EMSModeExternal }, time.Second, 10*time.Millisecond)
+	requires.Eventually(func() bool { return client.get(13049) == sungrow.EMSModeSelfConsumption }, 3*time.Second, 10*time.Millisecond)
+	requires.False(c.Status().External)
+}
+
+func TestControllerLostContact(t *testing.T) {
+	requires := require.New(t)
+
+	client := newFakeInverter()
+	c := newController(t, client)
+	c.MaxFailures = 1
+
+	done := make(chan error)
+	go func() { done <- c.Run(context.Background()) }()
+
+	client.mu.Lock()
+	client.broken = true
+	client.mu.Unlock()
+
+	c.Set(ems.Setpoint{Power: 1000})
+
+	select {
+	case err := <-done:
+		requires.ErrorIs(err, ems.ErrLostContact)
+	case <-time.After(2 * time.Second):
+		t.Fatal("controller didn't give up")
+	}
+}
+
+func TestControllerTimeout(t *testing.T) {
+	requires := require.New(t)
+
+	client := newFakeInverter()
+	c := newController(t, client)
+
+	c.Timeout = 30 * time.Second
+	requires.Error(c.Run(context.Background()))
+
+	c.Timeout = 100 * time.Millisecond
+	requires.Error(c.Run(context.Background()))
+
+	// The definition can hold the heartbeat to less
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(strings.Replace(registers, "max: 20", "max: 10", 1))))
+	c = ems.NewController(&inv, client)
+	c.Timeout = 15 * time.Second
+	requires.Error(c.Run(context.Background()))
+
+	// Nothing was written
+	requires.Zero(client.get(13049))
+	requires.Zero(client.get(13079))
+}
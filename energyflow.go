@@ -0,0 +1,179 @@
+package sungrow
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Bits of the running_state register on hybrid inverters
+const (
+	RunningStatePV          = 0x01
+	RunningStateCharging    = 0x02
+	RunningStateDischarging = 0x04
+	RunningStateLoad        = 0x08
+	RunningStateExporting   = 0x10
+	RunningStateImporting   = 0x20
+)
+
+const (
+	// Flows smaller than this are treated as noise when checking directions
+	flowDeadband = 10.0
+	// Smallest imbalance worth a warning, DC to AC losses are expected
+	flowTolerance = 100.0
+	// Largest imbalance as a fraction of throughput before a warning
+	flowLossRatio = 0.1
+)
+
+// ErrNotHybrid is returned when an energy flow is asked of an inverter without a battery.
+var ErrNotHybrid = errors.New("not a hybrid inverter")
+
+// EnergyFlow is a snapshot of the power flowing through a hybrid inverter in
+// watts, signed so that PV + Battery + Grid = Load.
+type EnergyFlow struct {
+	Model string `json:"model"`
+	// Generated by the panels, never negative
+	PV float64 `json:"pv"`
+	// Positive when discharging, negative when charging
+	Battery float64 `json:"battery"`
+	// Positive when importing, negative when exporting
+	Grid float64 `json:"grid"`
+	// Consumed by the house, never negative
+	Load         float64  `json:"load"`
+	RunningState int      `json:"running_state"`
+	Warnings     []string `json:"warnings,omitempty"`
+}
+
+// BatteryCharge returns the power going into the battery.
+func (f *EnergyFlow) BatteryCharge() float64 {
+	return math.Max(-f.Battery, 0)
+}
+
+// BatteryDischarge returns the power coming out of the battery.
+func (f *EnergyFlow) BatteryDischarge() float64 {
+	return math.Max(f.Battery, 0)
+}
+
+// GridImport returns the power bought from the grid.
+func (f *EnergyFlow) GridImport() float64 {
+	return math.Max(f.Grid, 0)
+}
+
+// GridExport returns the power fed into the grid.
+func (f *EnergyFlow) GridExport() float64 {
+	return math.Max(-f.Grid, 0)
+}
+
+// Imbalance returns the power unaccounted for, mostly conversion losses.
+func (f *EnergyFlow) Imbalance() float64 {
+	return f.PV + f.Battery + f.Grid - f.Load
+}
+
+// EnergyFlow builds a snapshot from the last read of a hybrid inverter.
+//
+// SH-RT models report battery_power signed, positive while charging, while
+// SH-RS and older models report the magnitude and leave the direction to
+// running_state. export_power is positive when feeding in on every hybrid.
+func (i *Inverter) EnergyFlow() (*EnergyFlow, error) {
+	if !i.Hybrid() {
+		return nil, ErrNotHybrid
+	}
+
+	f := &EnergyFlow{Model: i.Model()}
+
+	var missing []string
+	value := func(name string) float64 {
+		v, isa := i.Value(name)
+		if !isa {
+			missing = append(missing, name)
+		}
+		return v
+	}
+
+	f.PV = value("total_dc_power")
+	f.Load = value("load_power")
+	f.Grid = -value("export_power")
+	battery := value("battery_power")
+	state, hasState := i.Value("running_state")
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissing, strings.Join(missing, ", "))
+	}
+
+	f.RunningState = int(state)
+	charging := f.RunningState&RunningStateCharging != 0
+	discharging := f.RunningState&RunningStateDischarging != 0
+
+	if signedBattery(f.Model) {
+		if battery >= 1<<15 {
+			battery -= 1 << 16
+		}
+		f.Battery = -battery
+
+		if hasState && (charging && f.Battery > flowDeadband || discharging && f.Battery < -flowDeadband) {
+			f.warnf("battery_power of %.0fW disagrees with running_state", battery)
+		}
+	} else {
+		switch {
+		case charging && !discharging:
+			f.Battery = -battery
+		case discharging && !charging:
+			f.Battery = battery
+		case battery > flowDeadband:
+			f.warnf("battery_power of %.0fW without a direction in running_state", battery)
+		}
+	}
+
+	f.check(hasState)
+
+	return f, nil
+}
+
+// signedBattery reports whether the model reports battery_power signed.
+func signedBattery(model string) bool {
+	return strings.HasPrefix(model, "SH") && strings.Contains(model, "RT")
+}
+
+func (f *EnergyFlow) check(hasState bool) {
+	if f.PV < 0 {
+		f.warnf("negative PV power %.0fW", f.PV)
+	}
+
+	if f.Load < 0 {
+		f.warnf("negative load power %.0fW", f.Load)
+	}
+
+	if hasState {
+		state := f.RunningState
+
+		if state&RunningStateCharging != 0 && state&RunningStateDischarging != 0 {
+			f.warnf("running_state says charging and discharging")
+		}
+
+		if state&RunningStateExporting != 0 && state&RunningStateImporting != 0 {
+			f.warnf("running_state says exporting and importing")
+		}
+
+		if state&RunningStateExporting != 0 && f.Grid > flowDeadband {
+			f.warnf("running_state says exporting while importing %.0fW", f.Grid)
+		}
+
+		if state&RunningStateImporting != 0 && f.Grid < -flowDeadband {
+			f.warnf("running_state says importing while exporting %.0fW", -f.Grid)
+		}
+	}
+
+	if f.GridExport() > flowDeadband && f.BatteryCharge() > flowDeadband && f.BatteryCharge()+f.GridExport() > f.PV+flowTolerance {
+		f.warnf("charging %.0fW and exporting %.0fW from %.0fW of PV", f.BatteryCharge(), f.GridExport(), f.PV)
+	}
+
+	throughput := math.Max(f.PV+f.BatteryDischarge()+f.GridImport(), f.Load)
+	if imbalance := f.Imbalance(); math.Abs(imbalance) > math.Max(flowTolerance, throughput*flowLossRatio) {
+		f.warnf("flows don't balance, %.0fW unaccounted for", imbalance)
+	}
+}
+
+func (f *EnergyFlow) warnf(format string, v ...interface{}) {
+	f.Warnings = append(f.Warnings, fmt.Sprintf(format, v...))
+}
@@ -0,0 +1,133 @@
+package sungrow_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/internal/modbustest"
+	"github.com/stretchr/testify/require"
+)
+
+const energyFlowRegisters = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0x2433: "SG10RT"
+        0xD0F:
+          hybrid: true
+          name: "SH5.0RS"
+        0xE03:
+          hybrid: true
+          name: "SH10RT"
+    - address: 5017
+      name: "total_dc_power"
+      unit: "W"
+      type: "uint32"
+    - address: 13001
+      name: "running_state"
+      bits:
+        0x01: "Power generated from PV"
+        0x02: "Charging"
+        0x04: "Discharging"
+        0x08: "Load is active"
+        0x10: "Power feed-in the grid"
+        0x20: "Importing power from grid"
+    - address: 13008
+      name: "load_power"
+      unit: "W"
+      type: "int32"
+    - address: 13010
+      name: "export_power"
+      unit: "W"
+      type: "int32"
+    - address: 13022
+      name: "battery_power"
+      unit: "W"
+`
+
+// signExtend returns the high word of a negative 16 bit value widened to 32 bits
+func signExtend(v uint16) uint16 {
+	if v&0x8000 != 0 {
+		return 0xFFFF
+	}
+	return 0
+}
+
+func energyFlow(t *testing.T, code, dc, state, load, export, battery uint16) (*sungrow.Inverter, *sungrow.EnergyFlow, error) {
+	var inv sungrow.Inverter
+	require.NoError(t, inv.Define(strings.NewReader(energyFlowRegisters)))
+
+	require.NoError(t, inv.Read(&modbustest.Device{
+		Input: map[uint16]uint16{
+			4999:  code,
+			5016:  dc,
+			5017:  0,
+			13000: state,
+			13007: load,
+			13008: signExtend(load),
+			13009: export,
+			13010: signExtend(export),
+			13021: battery,
+		},
+	}))
+
+	flow, err := inv.EnergyFlow()
+	return &inv, flow, err
+}
+
+func TestEnergyFlowRS(t *testing.T) {
+	requires := require.New(t)
+
+	// PV covering the load, charging the battery and exporting the rest
+	inv, flow, err := energyFlow(t, 0xD0F, 5000, 0x01|0x02|0x08|0x10, 800, 1700, 2300)
+	requires.NoError(err)
+	requires.Equal("SH5.0RS", flow.Model)
+	requires.Equal(5000.0, flow.PV)
+	requires.Equal(-2300.0, flow.Battery)
+	requires.Equal(2300.0, flow.BatteryCharge())
+	requires.Equal(-1700.0, flow.Grid)
+	requires.Equal(1700.0, flow.GridExport())
+	requires.Equal(800.0, flow.Load)
+	requires.Equal(200.0, flow.Imbalance())
+	requires.Empty(flow.Warnings)
+
+	requires.Equal([]string{"Power generated from PV", "Charging", "Load is active", "Power feed-in the grid"}, inv.Registers.Input[2].Flags())
+
+	// Night time, battery discharging into the house
+	_, flow, err = energyFlow(t, 0xD0F, 0, 0x04|0x08, 600, 0, 620)
+	requires.NoError(err)
+	requires.Equal(620.0, flow.BatteryDischarge())
+	requires.Empty(flow.Warnings)
+
+	// Impossible states
+	_, flow, err = energyFlow(t, 0xD0F, 0, 0x02|0x04|0x10|0x20, 600, 0, 620)
+	requires.NoError(err)
+	requires.Contains(flow.Warnings, "running_state says charging and discharging")
+	requires.Contains(flow.Warnings, "running_state says exporting and importing")
+	requires.Contains(flow.Warnings, "battery_power of 620W without a direction in running_state")
+}
+
+func TestEnergyFlowRT(t *testing.T) {
+	requires := require.New(t)
+
+	// SH-RT reports the battery signed, positive charging, and export negative while importing
+	_, flow, err := energyFlow(t, 0xE03, 0, 0x04|0x08|0x20, 3000, 0xFFFF-999, 0xFFFF-1999)
+	requires.NoError(err)
+	requires.Equal(2000.0, flow.Battery)
+	requires.Equal(1000.0, flow.Grid)
+	requires.Equal(1000.0, flow.GridImport())
+	requires.Empty(flow.Warnings)
+
+	// Sign disagrees with running_state and the flows don't add up
+	_, flow, err = energyFlow(t, 0xE03, 0, 0x02, 3000, 0, 0xFFFF-1999)
+	requires.NoError(err)
+	requires.Equal([]string{
+		"battery_power of -2000W disagrees with running_state",
+		"flows don't balance, -1000W unaccounted for",
+	}, flow.Warnings)
+
+	_, _, err = energyFlow(t, 0x2433, 0, 0, 0, 0, 0)
+	requires.ErrorIs(err, sungrow.ErrNotHybrid)
+}
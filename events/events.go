@@ -0,0 +1,313 @@
+// Package events turns successive inverter reads into state transition events.
+package events
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/freman/sungrow"
+)
+
+// Kind of transition
+type Kind string
+
+const (
+	// A state register changed value, eg work_state
+	KindState Kind = "state"
+	// A bit of a bit field register was set or cleared, eg running_state
+	KindFlag Kind = "flag"
+	// A fault was raised or cleared
+	KindFault Kind = "fault"
+)
+
+const defaultHistorySize = 1000
+
+// DefaultRegisters are watched when Engine.Registers is empty, along with
+// every register that has a fault catalogue.
+var DefaultRegisters = []string{
+	"work_state",
+	"system_state",
+	"grid_state",
+	"bms_status",
+	"running_state",
+}
+
+// Event is a transition between two reads.
+type Event struct {
+	Time     time.Time `json:"time"`
+	Kind     Kind      `json:"kind"`
+	Register string    `json:"register"`
+	// Bit mask of a flag or the fault code, zero for states
+	Code int `json:"code,omitempty"`
+	// Flag and fault events go from 0 to 1 when set and 1 to 0 when cleared
+	Old      float64 `json:"old"`
+	New      float64 `json:"new"`
+	OldLabel string  `json:"old_label,omitempty"`
+	NewLabel string  `json:"new_label,omitempty"`
+	// Set for fault events
+	Fault *sungrow.Fault `json:"fault,omitempty"`
+}
+
+// Active reports whether a flag or fault was set rather than cleared.
+func (e Event) Active() bool {
+	return e.New != 0
+}
+
+func (e Event) String() string {
+	switch e.Kind {
+	case KindFlag, KindFault:
+		verb := "cleared"
+		if e.Active() {
+			verb = "set"
+		}
+		return fmt.Sprintf("%s %s: %s %s", e.Time.Format(time.RFC3339), e.Register, e.NewLabel, verb)
+	}
+
+	return fmt.Sprintf("%s %s: %s -> %s", e.Time.Format(time.RFC3339), e.Register, e.OldLabel, e.NewLabel)
+}
+
+// Engine compares reads and emits events for the transitions.
+type Engine struct {
+	// Registers to watch, see DefaultRegisters
+	Registers []string
+	// How long a change has to persist before it's emitted
+	Debounce time.Duration
+	// How many events to keep
+	HistorySize int
+
+	mu        sync.Mutex
+	confirmed map[string]signal
+	pending   map[string]pendingSignal
+	history   []Event
+	subs      map[*Subscription]struct{}
+}
+
+// signal is one observed value, a register state or a single flag or fault
+type signal struct {
+	kind     Kind
+	register string
+	code     int
+	value    float64
+	label    string
+	fault    *sungrow.Fault
+}
+
+type pendingSignal struct {
+	signal
+	since time.Time
+}
+
+// NewEngine allocates a new Engine.
+func NewEngine() *Engine {
+	return &Engine{
+		HistorySize: defaultHistorySize,
+	}
+}
+
+// Update feeds the engine a read taken at now, returning the events emitted.
+func (e *Engine) Update(inv *sungrow.Inverter, now time.Time) []Event {
+	current, read := e.observe(inv)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// The first read is the baseline
+	if e.confirmed == nil {
+		e.confirmed = current
+		e.pending = map[string]pendingSignal{}
+		return nil
+	}
+
+	for key, old := range e.confirmed {
+		// A flag or fault that's gone from a register read this time has
+		// been cleared, one whose register failed to read is still unknown
+		if _, isa := current[key]; !isa && old.kind != KindState && read[old.register] {
+			cleared := old
+			cleared.value = 0
+			current[key] = cleared
+		}
+	}
+
+	for key, p := range e.pending {
+		// A change that went away before it was confirmed starts over
+		if _, isa := current[key]; !isa && read[p.register] {
+			delete(e.pending, key)
+		}
+	}
+
+	var emitted []Event
+	for key, cur := range current {
+		old, known := e.confirmed[key]
+		if known && old.value == cur.value {
+			delete(e.pending, key)
+			continue
+		}
+
+		if !known && cur.kind != KindState && cur.value == 0 {
+			continue
+		}
+
+		p, isa := e.pending[key]
+		if !isa || p.value != cur.value {
+			p = pendingSignal{signal: cur, since: now}
+			e.pending[key] = p
+		}
+
+		if now.Sub(p.since) < e.Debounce {
+			continue
+		}
+
+		delete(e.pending, key)
+		if cur.kind != KindState && cur.value == 0 {
+			delete(e.confirmed, key)
+		} else {
+			e.confirmed[key] = cur
+		}
+
+		emitted = append(emitted, transition(old, cur, now))
+	}
+
+	sortEvents(emitted)
+
+	for _, ev := range emitted {
+		e.record(ev)
+	}
+
+	return emitted
+}
+
+func transition(old, cur signal, now time.Time) Event {
+	ev := Event{
+		Time:     now,
+		Kind:     cur.kind,
+		Register: cur.register,
+		Code:     cur.code,
+		Old:      old.value,
+		New:      cur.value,
+		OldLabel: old.label,
+		NewLabel: cur.label,
+		Fault:    cur.fault,
+	}
+
+	if cur.kind != KindState {
+		ev.OldLabel = cur.label
+		if cur.value == 0 {
+			ev.Fault = old.fault
+		}
+	}
+
+	return ev
+}
+
+// History returns the events kept, oldest first.
+func (e *Engine) History() []Event {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]Event(nil), e.history...)
+}
+
+// Reset forgets the baseline, history and anything pending.
+func (e *Engine) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.confirmed = nil
+	e.pending = nil
+	e.history = nil
+}
+
+func (e *Engine) record(ev Event) {
+	size := e.HistorySize
+	if size <= 0 {
+		size = defaultHistorySize
+	}
+
+	e.history = append(e.history, ev)
+	if over := len(e.history) - size; over > 0 {
+		e.history = append(e.history[:0], e.history[over:]...)
+	}
+
+	for s := range e.subs {
+		s.deliver(ev)
+	}
+}
+
+func (e *Engine) watched(r sungrow.Register) bool {
+	names := e.Registers
+	if len(names) == 0 {
+		if r.Faults != "" {
+			return true
+		}
+		names = DefaultRegisters
+	}
+
+	return contains(names, r.Name)
+}
+
+// observe collects the signals from a read and the watched registers that
+// were read successfully
+func (e *Engine) observe(inv *sungrow.Inverter) (map[string]signal, map[string]bool) {
+	signals := map[string]signal{}
+	read := map[string]bool{}
+
+	for _, set := range [][]sungrow.Register{inv.Registers.Input, inv.Registers.Holding} {
+		for idx := range set {
+			r := &set[idx]
+			if !r.Supported || r.Err != nil || !e.watched(*r) {
+				continue
+			}
+
+			v, isa := r.Float()
+			if !isa {
+				continue
+			}
+			read[r.Name] = true
+
+			if r.Faults != "" {
+				var faults []sungrow.Fault
+				switch x := r.Value.(type) {
+				case sungrow.Fault:
+					faults = []sungrow.Fault{x}
+				case []sungrow.Fault:
+					faults = x
+				}
+
+				for idx := range faults {
+					f := faults[idx]
+					signals[fmt.Sprintf("%s/%d", r.Name, f.Code)] = signal{kind: KindFault, register: r.Name, code: f.Code, value: 1, label: f.Description, fault: &f}
+				}
+				continue
+			}
+
+			if len(r.Bits) > 0 {
+				for mask, name := range r.Bits {
+					if int(v)&mask != 0 {
+						signals[fmt.Sprintf("%s/%d", r.Name, mask)] = signal{kind: KindFlag, register: r.Name, code: mask, value: 1, label: name}
+					}
+				}
+				continue
+			}
+
+			signals[r.Name] = signal{kind: KindState, register: r.Name, value: v, label: label(r.Value, v)}
+		}
+	}
+
+	return signals, read
+}
+
+func label(v interface{}, raw float64) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case map[string]interface{}:
+		if name, isa := x["name"].(string); isa {
+			return name
+		}
+	case nil:
+		return fmt.Sprint(raw)
+	}
+
+	return fmt.Sprint(v)
+}
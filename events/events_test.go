@@ -0,0 +1,160 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/events"
+	"github.com/freman/sungrow/internal/modbustest"
+	"github.com/stretchr/testify/require"
+)
+
+const registers = `registers:
+  input:
+    - address: 5038
+      name: "work_state"
+      values:
+        0x0: "Run"
+        0x8000: "Stop"
+    - address: 5045
+      name: "fault_code"
+      faults: "inverter"
+    - address: 13001
+      name: "running_state"
+      bits:
+        0x01: "Power generated from PV"
+        0x02: "Charging"
+    - address: 13023
+      name: "battery_level"
+faults:
+  inverter:
+    codes:
+      - code: 2
+        description: "Grid overvoltage"
+`
+
+func read(t *testing.T, workState, fault, running, level uint16) *sungrow.Inverter {
+	return modbustest.Read(t, registers, map[uint16]uint16{5037: workState, 5044: fault, 13000: running, 13022: level})
+}
+
+func TestEngine(t *testing.T) {
+	requires := require.New(t)
+
+	engine := events.NewEngine()
+	engine.HistorySize = 4
+	faults := engine.Subscribe(events.Filter{Kinds: []events.Kind{events.KindFault}}, 10)
+	charging := engine.Subscribe(events.Filter{Registers: []string{"running_state"}, ActiveOnly: true}, 1)
+
+	start := time.Date(2023, 11, 4, 13, 37, 0, 0, time.UTC)
+
+	requires.Empty(engine.Update(read(t, 0, 0, 0x01, 50), start))
+	requires.Empty(engine.Update(read(t, 0, 0, 0x01, 51), start.Add(time.Minute)))
+
+	evs := engine.Update(read(t, 0x8000, 2, 0x02, 52), start.Add(2*time.Minute))
+	requires.Len(evs, 4)
+	requires.Equal(events.Event{
+		Time:     start.Add(2 * time.Minute),
+		Kind:     events.KindFault,
+		Register: "fault_code",
+		Code:     2,
+		Old:      0,
+		New:      1,
+		OldLabel: "Grid overvoltage",
+		NewLabel: "Grid overvoltage",
+		Fault:    &sungrow.Fault{Code: 2, Description: "Grid overvoltage", Severity: sungrow.SeverityFault},
+	}, evs[0])
+	requires.Equal("running_state", evs[1].Register)
+	requires.Equal(0x01, evs[1].Code)
+	requires.False(evs[1].Active())
+	requires.Equal(0x02, evs[2].Code)
+	requires.True(evs[2].Active())
+	requires.Equal("2023-11-04T13:39:00Z work_state: Run -> Stop", evs[3].String())
+
+	evs = engine.Update(read(t, 0x8000, 0, 0x02, 52), start.Add(3*time.Minute))
+	requires.Len(evs, 1)
+	requires.False(evs[0].Active())
+	requires.Equal("Grid overvoltage", evs[0].Fault.Description)
+
+	requires.Len(engine.History(), 4)
+	requires.Equal("work_state", engine.History()[2].Register)
+
+	requires.Len(faults.C, 2)
+	requires.Equal(0, faults.Dropped())
+	requires.Equal(0x02, (<-charging.C).Code)
+	requires.Equal(0, charging.Dropped())
+
+	engine.Unsubscribe(faults)
+	_, open := <-faults.C
+	requires.True(open)
+	_, open = <-faults.C
+	requires.True(open)
+	_, open = <-faults.C
+	requires.False(open)
+}
+
+func TestEngineDebounce(t *testing.T) {
+	requires := require.New(t)
+
+	engine := events.NewEngine()
+	engine.Debounce = time.Minute
+
+	start := time.Date(2023, 11, 4, 13, 37, 0, 0, time.UTC)
+	requires.Empty(engine.Update(read(t, 0, 0, 0, 0), start))
+
+	// A blip that goes away isn't reported
+	requires.Empty(engine.Update(read(t, 0x8000, 0, 0, 0), start.Add(10*time.Second)))
+	requires.Empty(engine.Update(read(t, 0, 0, 0, 0), start.Add(20*time.Second)))
+
+	requires.Empty(engine.Update(read(t, 0x8000, 0, 0, 0), start.Add(30*time.Second)))
+	requires.Empty(engine.Update(read(t, 0x8000, 0, 0, 0), start.Add(60*time.Second)))
+	evs := engine.Update(read(t, 0x8000, 0, 0, 0), start.Add(90*time.Second))
+	requires.Len(evs, 1)
+	requires.Equal("Stop", evs[0].NewLabel)
+
+	requires.Empty(engine.Update(read(t, 0x8000, 0, 0, 0), start.Add(120*time.Second)))
+	requires.Len(engine.History(), 1)
+}
+
+func TestEngineDebounceFlags(t *testing.T) {
+	requires := require.New(t)
+
+	engine := events.NewEngine()
+	engine.Debounce = 30 * time.Second
+
+	start := time.Date(2023, 11, 4, 13, 37, 0, 0, time.UTC)
+	requires.Empty(engine.Update(read(t, 0, 0, 0, 0), start))
+
+	// Charging blips on and off, when it comes back it has to last again
+	requires.Empty(engine.Update(read(t, 0, 0, 0x02, 0), start.Add(10*time.Second)))
+	requires.Empty(engine.Update(read(t, 0, 0, 0, 0), start.Add(20*time.Second)))
+	requires.Empty(engine.Update(read(t, 0, 0, 0x02, 0), start.Add(10*time.Minute)))
+
+	evs := engine.Update(read(t, 0, 0, 0x02, 0), start.Add(10*time.Minute+30*time.Second))
+	requires.Len(evs, 1)
+	requires.Equal("Charging", evs[0].NewLabel)
+	requires.True(evs[0].Active())
+}
+
+func TestEngineFailedRead(t *testing.T) {
+	requires := require.New(t)
+
+	engine := events.NewEngine()
+	faults := engine.Subscribe(events.Filter{Severities: []sungrow.Severity{sungrow.SeverityFault}}, 10)
+	start := time.Date(2023, 11, 4, 13, 37, 0, 0, time.UTC)
+
+	requires.Empty(engine.Update(read(t, 0, 0, 0x01, 50), start))
+	requires.Len(engine.Update(read(t, 0, 2, 0x01, 50), start.Add(time.Minute)), 1)
+
+	// The fault and flags can't be read, that isn't them clearing
+	inv := modbustest.Read(t, registers, map[uint16]uint16{5037: 0, 13022: 50})
+	requires.Empty(engine.Update(inv, start.Add(2*time.Minute)))
+
+	requires.Empty(engine.Update(read(t, 0, 2, 0x01, 50), start.Add(3*time.Minute)))
+
+	evs := engine.Update(read(t, 0, 0, 0x01, 50), start.Add(4*time.Minute))
+	requires.Len(evs, 1)
+	requires.False(evs[0].Active())
+
+	requires.Len(faults.C, 2)
+}
@@ -0,0 +1,132 @@
+package events
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/freman/sungrow"
+)
+
+// Filter selects events, empty fields match everything.
+type Filter struct {
+	Kinds     []Kind
+	Registers []string
+	// Only fault events at these severities
+	Severities []sungrow.Severity
+	// Only set, not cleared, flags and faults
+	ActiveOnly bool
+}
+
+// Match reports whether the event passes the filter.
+func (f Filter) Match(ev Event) bool {
+	if len(f.Kinds) > 0 {
+		found := false
+		for _, k := range f.Kinds {
+			found = found || k == ev.Kind
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.Registers) > 0 && !contains(f.Registers, ev.Register) {
+		return false
+	}
+
+	if len(f.Severities) > 0 && (ev.Fault == nil || !hasSeverity(f.Severities, ev.Fault.Severity)) {
+		return false
+	}
+
+	if f.ActiveOnly && ev.Kind != KindState && !ev.Active() {
+		return false
+	}
+
+	return true
+}
+
+func contains(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func hasSeverity(list []sungrow.Severity, v sungrow.Severity) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscription receives the events matching its filter.
+type Subscription struct {
+	C <-chan Event
+
+	filter  Filter
+	c       chan Event
+	mu      sync.Mutex
+	dropped int
+}
+
+// Subscribe returns a subscription buffering up to size events, events are
+// dropped rather than blocking the engine when the buffer is full.
+func (e *Engine) Subscribe(filter Filter, size int) *Subscription {
+	c := make(chan Event, size)
+	s := &Subscription{C: c, c: c, filter: filter}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.subs == nil {
+		e.subs = map[*Subscription]struct{}{}
+	}
+	e.subs[s] = struct{}{}
+
+	return s
+}
+
+// Unsubscribe stops delivery and closes the subscription's channel.
+func (e *Engine) Unsubscribe(s *Subscription) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if _, isa := e.subs[s]; isa {
+		delete(e.subs, s)
+		close(s.c)
+	}
+}
+
+// Dropped returns how many events didn't fit in the buffer.
+func (s *Subscription) Dropped() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.dropped
+}
+
+func (s *Subscription) deliver(ev Event) {
+	if !s.filter.Match(ev) {
+		return
+	}
+
+	select {
+	case s.c <- ev:
+	default:
+		s.mu.Lock()
+		s.dropped++
+		s.mu.Unlock()
+	}
+}
+
+func sortEvents(evs []Event) {
+	sort.Slice(evs, func(i, j int) bool {
+		if evs[i].Register != evs[j].Register {
+			return evs[i].Register < evs[j].Register
+		}
+		return evs[i].Code < evs[j].Code
+	})
+}
@@ -0,0 +1,513 @@
+// Package exportlimit keeps an inverter's export limit in line with a
+// schedule or an externally supplied cap.
+package exportlimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/schedule"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	limitEnable  = 0xAA
+	limitDisable = 0x55
+
+	defaultMinInterval = 5 * time.Minute
+	defaultSettle      = time.Minute
+	// Export allowed over the limit before it's considered ignored
+	defaultTolerance = 100.0
+)
+
+// Registers read each step, everything else is skipped
+var readRegisters = map[string]bool{
+	"device_type_code":                   true,
+	"export_power_limitation":            true,
+	"export_power_limitation_value":      true,
+	"export_power_limitation_percentage": true,
+	"export_power":                       true,
+	"meter_power":                        true,
+	"nominal_active_power":               true,
+}
+
+// Limit is an export cap, in watts or as a percentage of the inverter's
+// rating, or no cap at all.
+type Limit struct {
+	Watts     float64 `yaml:"watts,omitempty" json:"watts,omitempty"`
+	Percent   float64 `yaml:"percent,omitempty" json:"percent,omitempty"`
+	Unlimited bool    `yaml:"unlimited,omitempty" json:"unlimited,omitempty"`
+}
+
+func (l Limit) String() string {
+	switch {
+	case l.Unlimited:
+		return "unlimited"
+	case l.Percent > 0:
+		return fmt.Sprintf("%g%%", l.Percent)
+	}
+	return fmt.Sprintf("%gW", l.Watts)
+}
+
+// tighter reports whether l allows less export than o
+func (l Limit) tighter(o Limit) bool {
+	switch {
+	case l.Unlimited:
+		return false
+	case o.Unlimited:
+		return true
+	case l.Percent > 0 && o.Percent > 0:
+		return l.Percent < o.Percent
+	case l.Percent == 0 && o.Percent == 0:
+		return l.Watts < o.Watts
+	}
+	// Can't compare watts and percent, err on the side of the network
+	return true
+}
+
+// Schedule is the YAML export limit schedule, eg
+//
+//	timezone: Australia/Brisbane
+//	default:
+//	  watts: 5000
+//	windows:
+//	  - start: "10:00"
+//	    end: "14:00"
+//	    limit:
+//	      watts: 0
+//	    reason: "midday network cap"
+type Schedule struct {
+	Timezone string   `yaml:"timezone,omitempty"`
+	Default  Limit    `yaml:"default"`
+	Windows  []Window `yaml:"windows,omitempty"`
+
+	location *time.Location
+}
+
+// Window applies a limit between Start and End, windows that end before
+// they start run over midnight.
+type Window struct {
+	Start  schedule.Clock `yaml:"start"`
+	End    schedule.Clock `yaml:"end"`
+	Limit  Limit          `yaml:"limit"`
+	Reason string         `yaml:"reason,omitempty"`
+}
+
+// Load reads a schedule.
+func Load(r io.Reader) (*Schedule, error) {
+	var s Schedule
+	if err := yaml.NewDecoder(r).Decode(&s); err != nil {
+		return nil, err
+	}
+
+	s.location = time.Local
+	if s.Timezone != "" {
+		loc, err := time.LoadLocation(s.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		s.location = loc
+	}
+
+	for _, w := range s.Windows {
+		if w.Start == w.End {
+			return nil, fmt.Errorf("window at %s starts when it ends", w.Start)
+		}
+	}
+
+	return &s, nil
+}
+
+// LoadFile reads a schedule file.
+func LoadFile(name string) (*Schedule, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return Load(f)
+}
+
+// At returns the limit in effect at t and why.
+func (s *Schedule) At(t time.Time) (Limit, string) {
+	if s.location != nil {
+		t = t.In(s.location)
+	}
+
+	now := schedule.Clock(t.Hour()*60 + t.Minute())
+	for _, w := range s.Windows {
+		in := now >= w.Start && now < w.End
+		if w.Start > w.End {
+			in = now >= w.Start || now < w.End
+		}
+
+		if in {
+			reason := w.Reason
+			if reason == "" {
+				reason = fmt.Sprintf("scheduled %s-%s", w.Start, w.End)
+			}
+			return w.Limit, reason
+		}
+	}
+
+	return s.Default, "scheduled default"
+}
+
+// Status is what the controller last did.
+type Status struct {
+	Limit     Limit     `json:"limit"`
+	Reason    string    `json:"reason"`
+	Applied   bool      `json:"applied"`
+	Confirmed bool      `json:"confirmed"`
+	Export    float64   `json:"export"`
+	LastWrite time.Time `json:"last_write,omitempty"`
+}
+
+// Controller applies export limits.
+type Controller struct {
+	Inverter *sungrow.Inverter
+	Client   sungrow.ModbusWriter
+	// Limits to follow when there's no override, optional
+	Schedule *Schedule
+	// Least time between writes, tightening the limit isn't held back
+	MinInterval time.Duration
+	// How long the inverter gets to act on a new limit before it's checked
+	Settle time.Duration
+	// Export in W over the limit that's tolerated
+	Tolerance float64
+	Logger    *log.Logger
+
+	mu       sync.Mutex
+	override *override
+	status   Status
+	warned   bool
+}
+
+type override struct {
+	limit   Limit
+	reason  string
+	expires time.Time
+}
+
+// NewController allocates a new Controller.
+func NewController(inv *sungrow.Inverter, client sungrow.ModbusWriter, s *Schedule) *Controller {
+	return &Controller{
+		Inverter:    inv,
+		Client:      client,
+		Schedule:    s,
+		MinInterval: defaultMinInterval,
+		Settle:      defaultSettle,
+		Tolerance:   defaultTolerance,
+	}
+}
+
+// Set overrides the schedule until expires, a zero expires never does.
+func (c *Controller) Set(l Limit, reason string, expires time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if reason == "" {
+		reason = "override"
+	}
+
+	c.override = &override{limit: l, reason: reason, expires: expires}
+}
+
+// Clear removes any override.
+func (c *Controller) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.override = nil
+}
+
+// Status returns what the controller last did.
+func (c *Controller) Status() Status {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.status
+}
+
+// wanted returns the limit that should be in effect at now
+func (c *Controller) wanted(now time.Time) (Limit, string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.override != nil {
+		if c.override.expires.IsZero() || now.Before(c.override.expires) {
+			return c.override.limit, c.override.reason, true
+		}
+
+		c.logf("exportlimit: override %s (%s) expired", c.override.limit, c.override.reason)
+		c.override = nil
+	}
+
+	if c.Schedule == nil {
+		return Limit{}, "", false
+	}
+
+	l, reason := c.Schedule.At(now)
+	return l, reason, true
+}
+
+// Step reads the inverter, writes the limit wanted at now if it differs and
+// checks the export is within the limit.
+func (c *Controller) Step(now time.Time) error {
+	err := c.Inverter.ReadWithSkip(c.Client, func(r sungrow.Register, funcCode int) bool {
+		return !readRegisters[r.Name]
+	})
+	if err != nil {
+		return err
+	}
+
+	limit, reason, isa := c.wanted(now)
+	if !isa {
+		return nil
+	}
+
+	c.mu.Lock()
+	changed := c.status.Limit != limit || c.status.Reason == ""
+	if changed {
+		c.status.Applied = false
+	}
+	c.status.Limit, c.status.Reason = limit, reason
+	status := c.status
+	c.mu.Unlock()
+
+	current, known := c.current()
+
+	switch {
+	case known && current == limit:
+		if !status.Applied {
+			c.markApplied(now, false)
+			status.Applied = true
+		}
+	case c.held(status, current, known, limit, now):
+		if changed {
+			c.logf("exportlimit: holding %s (%s) back, last write %s ago", limit, reason, now.Sub(status.LastWrite).Round(time.Second))
+		}
+		return nil
+	default:
+		from := "unknown"
+		if known {
+			from = current.String()
+		}
+		c.logf("exportlimit: %s -> %s, %s", from, limit, reason)
+
+		if err := c.apply(limit); err != nil {
+			return err
+		}
+
+		c.markApplied(now, true)
+		return nil
+	}
+
+	c.verify(limit, now)
+	return nil
+}
+
+// held reports whether a write has to wait to protect the EEPROM
+func (c *Controller) held(status Status, current Limit, known bool, limit Limit, now time.Time) bool {
+	if status.LastWrite.IsZero() || now.Sub(status.LastWrite) >= c.MinInterval {
+		return false
+	}
+
+	return !known || !limit.tighter(current)
+}
+
+func (c *Controller) markApplied(now time.Time, wrote bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.status.Applied = true
+	c.status.Confirmed = false
+	c.warned = false
+	if wrote {
+		c.status.LastWrite = now
+	}
+}
+
+// current returns the limit the inverter has
+func (c *Controller) current() (Limit, bool) {
+	sw := c.Inverter.Holding("export_power_limitation")
+	if sw == nil || !sw.Supported || sw.Err != nil {
+		return Limit{}, false
+	}
+
+	v, _ := sw.Float()
+	if int(v) != limitEnable {
+		return Limit{Unlimited: true}, true
+	}
+
+	if r := c.Inverter.Holding("export_power_limitation_percentage"); r != nil && r.Supported && r.Err == nil {
+		if p, isa := r.Float(); isa && p > 0 && p < 100 {
+			return Limit{Percent: p}, true
+		}
+	}
+
+	if r := c.Inverter.Holding("export_power_limitation_value"); r != nil && r.Supported && r.Err == nil {
+		if w, isa := r.Float(); isa {
+			return Limit{Watts: w}, true
+		}
+	}
+
+	return Limit{}, false
+}
+
+func (c *Controller) apply(l Limit) error {
+	if l.Unlimited {
+		return c.Inverter.Write(c.Client, "export_power_limitation", limitDisable)
+	}
+
+	if l.Percent > 0 {
+		if err := c.Inverter.Write(c.Client, "export_power_limitation_percentage", l.Percent); err != nil {
+			return err
+		}
+	} else {
+		if err := c.Inverter.Write(c.Client, "export_power_limitation_value", l.Watts); err != nil {
+			return err
+		}
+
+		// A percentage would otherwise take precedence
+		if r := c.Inverter.Holding("export_power_limitation_percentage"); r != nil && r.Supported && r.Err == nil {
+			if err := c.Inverter.Write(c.Client, "export_power_limitation_percentage", 100.0); err != nil {
+				return err
+			}
+		}
+	}
+
+	return c.Inverter.Write(c.Client, "export_power_limitation", limitEnable)
+}
+
+// export returns the power being exported in W
+func (c *Controller) export() (float64, bool) {
+	if c.Inverter.Hybrid() {
+		if v, isa := c.Inverter.Value("input.export_power"); isa {
+			return v, true
+		}
+	}
+
+	if v, isa := c.Inverter.Value("input.meter_power"); isa {
+		// Positive when importing
+		return -v, true
+	}
+
+	return 0, false
+}
+
+func (c *Controller) verify(l Limit, now time.Time) {
+	export, isa := c.export()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.status.Export = export
+	if !isa || l.Unlimited || now.Sub(c.status.LastWrite) < c.Settle {
+		return
+	}
+
+	capWatts := l.Watts
+	if l.Percent > 0 {
+		rated, isa := c.Inverter.Value("nominal_active_power")
+		if !isa {
+			return
+		}
+		// Rated in kW
+		capWatts = rated * 1000 * l.Percent / 100
+	}
+
+	ok := export <= capWatts+math.Max(c.Tolerance, 0)
+	c.status.Confirmed = ok
+
+	if !ok && !c.warned {
+		c.warned = true
+		c.logf("exportlimit: exporting %.0fW despite a limit of %s (%s)", export, l, c.status.Reason)
+	}
+}
+
+func (c *Controller) logf(format string, v ...interface{}) {
+	if c.Logger != nil {
+		c.Logger.Printf(format, v...)
+	}
+}
+
+// ParseLimit parses a limit such as 5000, 5000W, 50% or unlimited.
+func ParseLimit(s string) (Limit, error) {
+	s = strings.TrimSpace(strings.ToLower(s))
+
+	if s == "unlimited" || s == "off" {
+		return Limit{Unlimited: true}, nil
+	}
+
+	var v float64
+	if strings.HasSuffix(s, "%") {
+		if _, err := fmt.Sscanf(strings.TrimSuffix(s, "%"), "%g", &v); err != nil || v <= 0 || v > 100 {
+			return Limit{}, fmt.Errorf("invalid limit %q", s)
+		}
+		return Limit{Percent: v}, nil
+	}
+
+	if _, err := fmt.Sscanf(strings.TrimSuffix(s, "w"), "%g", &v); err != nil || v < 0 {
+		return Limit{}, fmt.Errorf("invalid limit %q", s)
+	}
+
+	return Limit{Watts: v}, nil
+}
+
+// ServeHTTP returns the status on GET, takes an override such as
+// {"limit": "1500W", "reason": "network request", "for": "2h"} on POST or PUT
+// and clears the override on DELETE.
+func (c *Controller) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost, http.MethodPut:
+		var req struct {
+			Limit  string `json:"limit"`
+			Reason string `json:"reason"`
+			For    string `json:"for"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		l, err := ParseLimit(req.Limit)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var expires time.Time
+		if req.For != "" {
+			d, err := time.ParseDuration(req.For)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			expires = time.Now().Add(d)
+		}
+
+		c.Set(l, req.Reason, expires)
+	case http.MethodDelete:
+		c.Clear()
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(c.Status())
+}
@@ -0,0 +1,189 @@
+package exportlimit_test
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/exportlimit"
+	"github.com/freman/sungrow/internal/modbustest"
+	"github.com/stretchr/testify/require"
+)
+
+const registers = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0x147: "SG5KTL-MT"
+    - address: 5001
+      name: "nominal_active_power"
+      unit: "kW"
+      scale: 0.1
+    - address: 5083
+      name: "meter_power"
+      type: "int32"
+      unit: "W"
+  holding:
+    - address: 5010
+      name: "export_power_limitation"
+      values:
+        0xAA: "enable"
+        0x55: "disable"
+    - address: 5011
+      name: "export_power_limitation_value"
+    - address: 5015
+      name: "export_power_limitation_percentage"
+      scale: 0.1
+      unit: "%"
+`
+
+const testSchedule = `timezone: UTC
+default:
+  watts: 5000
+windows:
+  - start: "10:00"
+    end: "14:00"
+    limit:
+      watts: 0
+    reason: "midday network cap"
+`
+
+// meter sets the meter power, negative when exporting
+func meter(d *modbustest.Device, w int32) {
+	modbustest.Set32(d.Input, 5082, uint32(w))
+}
+
+func TestController(t *testing.T) {
+	requires := require.New(t)
+
+	s, err := exportlimit.Load(strings.NewReader(testSchedule))
+	requires.NoError(err)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(registers)))
+
+	client := &modbustest.Device{
+		Input:   map[uint16]uint16{4999: 0x147, 5000: 50},
+		Holding: map[uint16]uint16{5009: 0x55, 5010: 0, 5014: 1000},
+	}
+	meter(client, -4000)
+
+	var logged bytes.Buffer
+	c := exportlimit.NewController(&inv, client, s)
+	c.Logger = log.New(&logged, "", 0)
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2023, 11, 4, hour, minute, 0, 0, time.UTC)
+	}
+
+	requires.NoError(c.Step(at(9, 58)))
+	requires.Equal(map[uint16]uint16{5009: 0xAA, 5010: 5000, 5014: 1000}, client.Holding)
+	requires.Contains(logged.String(), "exportlimit: unlimited -> 5000W, scheduled default\n")
+
+	// Not checked until it's had time to settle
+	requires.NoError(c.Step(at(9, 58).Add(30 * time.Second)))
+	requires.False(c.Status().Confirmed)
+	requires.NoError(c.Step(at(9, 59)))
+	requires.True(c.Status().Confirmed)
+	requires.Equal(4000.0, c.Status().Export)
+
+	// Tightening isn't held back
+	client.Writes = 0
+	requires.NoError(c.Step(at(10, 0)))
+	requires.Equal(uint16(0), client.Holding[5010])
+	requires.Contains(logged.String(), "exportlimit: 5000W -> 0W, midday network cap\n")
+	requires.Equal(3, client.Writes)
+
+	requires.NoError(c.Step(at(10, 2)))
+	requires.False(c.Status().Confirmed)
+	requires.Contains(logged.String(), "exportlimit: exporting 4000W despite a limit of 0W (midday network cap)\n")
+
+	meter(client, 200)
+	requires.NoError(c.Step(at(10, 3)))
+	requires.True(c.Status().Confirmed)
+
+	// Loosening waits for the rate limit
+	client.Writes = 0
+	c.Set(exportlimit.Limit{Unlimited: true}, "network operator lifted the cap", time.Time{})
+	requires.NoError(c.Step(at(10, 3)))
+	requires.NoError(c.Step(at(10, 4)))
+	requires.Equal(0, client.Writes)
+	requires.False(c.Status().Applied)
+	requires.Equal(1, strings.Count(logged.String(), "holding unlimited"))
+
+	requires.NoError(c.Step(at(10, 5)))
+	requires.Equal(uint16(0x55), client.Holding[5009])
+	requires.True(c.Status().Applied)
+
+	// Nothing to do when the inverter already has it
+	client.Writes = 0
+	c.Set(exportlimit.Limit{Percent: 50}, "", at(10, 30))
+	requires.NoError(c.Step(at(10, 11)))
+	requires.Equal(uint16(500), client.Holding[5014])
+	requires.Equal(uint16(0xAA), client.Holding[5009])
+	requires.NoError(c.Step(at(10, 20)))
+	requires.Equal(2, client.Writes)
+
+	// 50% of 5kW
+	meter(client, -2700)
+	requires.NoError(c.Step(at(10, 21)))
+	requires.False(c.Status().Confirmed)
+
+	// The override expires back into the schedule
+	requires.NoError(c.Step(at(10, 31)))
+	requires.Equal(uint16(0), client.Holding[5010])
+	requires.Equal(uint16(1000), client.Holding[5014])
+	requires.Equal("midday network cap", c.Status().Reason)
+}
+
+func TestParseLimit(t *testing.T) {
+	requires := require.New(t)
+
+	for s, expected := range map[string]exportlimit.Limit{
+		"5000":      {Watts: 5000},
+		"1500W":     {Watts: 1500},
+		"0":         {},
+		"50%":       {Percent: 50},
+		"unlimited": {Unlimited: true},
+	} {
+		l, err := exportlimit.ParseLimit(s)
+		requires.NoError(err, s)
+		requires.Equal(expected, l, s)
+	}
+
+	for _, s := range []string{"lots", "-1", "150%"} {
+		_, err := exportlimit.ParseLimit(s)
+		requires.Error(err, s)
+	}
+}
+
+func TestControllerReadsOnlyWhatItNeeds(t *testing.T) {
+	requires := require.New(t)
+
+	s, err := exportlimit.Load(strings.NewReader(testSchedule))
+	requires.NoError(err)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(strings.Replace(registers, "  holding:\n", `    - address: 5003
+      name: "daily_power_yields"
+  holding:
+`, 1))))
+
+	client := &modbustest.Device{
+		Input:   map[uint16]uint16{4999: 0x147, 5000: 50, 5002: 123},
+		Holding: map[uint16]uint16{5009: 0x55, 5010: 0, 5014: 1000},
+	}
+	meter(client, -4000)
+
+	c := exportlimit.NewController(&inv, client, s)
+	requires.NoError(c.Step(time.Date(2023, 11, 4, 9, 58, 0, 0, time.UTC)))
+
+	_, isa := inv.Value("daily_power_yields")
+	requires.False(isa)
+	_, isa = inv.Value("meter_power")
+	requires.True(isa)
+}
@@ -0,0 +1,519 @@
+package sungrow
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expression is a parsed formula over register names, eg
+//
+//	max(-export_power, 0)
+//	{holding.power_limitation_switch} == 0xAA
+//
+// Names may be prefixed with input. or holding. to pick a bank and wrapped in
+// braces, comparisons and logic evaluate to 1 or 0 and the functions abs, min,
+// max, clamp(x, lo, hi), if(cond, a, b) and bit(x, mask) are available. A
+// register can be compared with a quoted label of its values, eg
+// grid_state == "off-grid", when the expression is parsed with Labels.
+type Expression struct {
+	source string
+	root   node
+}
+
+// Lookup resolves a register name to its numeric value.
+type Lookup func(name string) (float64, bool)
+
+type node interface {
+	eval(lookup Lookup) (float64, error)
+}
+
+// Labels resolves a label of the named register's values to the value.
+type Labels func(name, label string) (float64, bool)
+
+// ParseExpression parses a formula.
+func ParseExpression(s string) (*Expression, error) {
+	return ParseExpressionLabels(s, nil)
+}
+
+// ParseExpressionLabels parses a formula, resolving the labels compared with
+// registers.
+func ParseExpressionLabels(s string, labels Labels) (*Expression, error) {
+	p := &exprParser{tokens: tokenizeExpression(s)}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", s, err)
+	}
+
+	if t := p.peek(); t != "" {
+		return nil, fmt.Errorf("failed to parse %q: unexpected %q", s, t)
+	}
+
+	if root, err = resolveLabels(root, labels); err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", s, err)
+	}
+
+	e := &Expression{source: s, root: root}
+	for _, name := range e.Names() {
+		if bank, _ := splitBank(name); bank == "" && strings.Contains(name, ".") {
+			return nil, fmt.Errorf("failed to parse %q: %q isn't in the input, holding or computed bank", s, name)
+		}
+	}
+
+	return e, nil
+}
+
+// Eval evaluates the expression, looking up names as it goes.
+func (e *Expression) Eval(lookup Lookup) (float64, error) {
+	return e.root.eval(lookup)
+}
+
+// Names returns the register names the expression refers to.
+func (e *Expression) Names() []string {
+	var names []string
+	var walk func(n node)
+	walk = func(n node) {
+		switch x := n.(type) {
+		case exprName:
+			names = append(names, string(x))
+		case exprUnary:
+			walk(x.operand)
+		case exprBinary:
+			walk(x.left)
+			walk(x.right)
+		case exprCall:
+			for _, a := range x.args {
+				walk(a)
+			}
+		}
+	}
+	walk(e.root)
+	return names
+}
+
+func (e *Expression) String() string {
+	return e.source
+}
+
+// ErrMissing is returned when a name can't be resolved.
+var ErrMissing = errors.New("missing value")
+
+type exprNumber float64
+
+func (n exprNumber) eval(Lookup) (float64, error) {
+	return float64(n), nil
+}
+
+type exprName string
+
+func (n exprName) eval(lookup Lookup) (float64, error) {
+	if v, isa := lookup(string(n)); isa {
+		return v, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrMissing, string(n))
+}
+
+// exprLabel is a quoted label, replaced by its value once resolved
+type exprLabel string
+
+func (l exprLabel) eval(Lookup) (float64, error) {
+	return 0, fmt.Errorf("label %q isn't resolved", string(l))
+}
+
+// resolveLabels replaces labels compared with a name by their values
+func resolveLabels(n node, labels Labels) (node, error) {
+	var err error
+
+	switch x := n.(type) {
+	case exprLabel:
+		return nil, fmt.Errorf("label %q can only be compared with a register", string(x))
+	case exprUnary:
+		x.operand, err = resolveLabels(x.operand, labels)
+		return x, err
+	case exprBinary:
+		if x.op == "==" || x.op == "!=" {
+			if name, isa := x.left.(exprName); isa {
+				if x.right, err = resolveLabel(name, x.right, labels); err != nil {
+					return nil, err
+				}
+			}
+			if name, isa := x.right.(exprName); isa {
+				if x.left, err = resolveLabel(name, x.left, labels); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if x.left, err = resolveLabels(x.left, labels); err != nil {
+			return nil, err
+		}
+		x.right, err = resolveLabels(x.right, labels)
+		return x, err
+	case exprCall:
+		args := make([]node, len(x.args))
+		for i, a := range x.args {
+			if args[i], err = resolveLabels(a, labels); err != nil {
+				return nil, err
+			}
+		}
+		x.args = args
+		return x, nil
+	}
+
+	return n, nil
+}
+
+func resolveLabel(name exprName, n node, labels Labels) (node, error) {
+	l, isa := n.(exprLabel)
+	if !isa {
+		return n, nil
+	}
+
+	if labels == nil {
+		return nil, fmt.Errorf("no values to look label %q of %s up in", string(l), string(name))
+	}
+
+	v, isa := labels(string(name), string(l))
+	if !isa {
+		return nil, fmt.Errorf("%s has no value labelled %q", string(name), string(l))
+	}
+
+	return exprNumber(v), nil
+}
+
+type exprUnary struct {
+	op      string
+	operand node
+}
+
+func (u exprUnary) eval(lookup Lookup) (float64, error) {
+	v, err := u.operand.eval(lookup)
+	if err != nil {
+		return 0, err
+	}
+
+	if u.op == "!" {
+		return exprBool(v == 0), nil
+	}
+
+	return -v, nil
+}
+
+type exprBinary struct {
+	op          string
+	left, right node
+}
+
+func (b exprBinary) eval(lookup Lookup) (float64, error) {
+	l, err := b.left.eval(lookup)
+	if err != nil {
+		return 0, err
+	}
+
+	// Short circuit so conditions can guard missing values
+	switch {
+	case b.op == "&&" && l == 0:
+		return 0, nil
+	case b.op == "||" && l != 0:
+		return 1, nil
+	}
+
+	r, err := b.right.eval(lookup)
+	if err != nil {
+		return 0, err
+	}
+
+	switch b.op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return l / r, nil
+	case "==":
+		return exprBool(l == r), nil
+	case "!=":
+		return exprBool(l != r), nil
+	case "<":
+		return exprBool(l < r), nil
+	case "<=":
+		return exprBool(l <= r), nil
+	case ">":
+		return exprBool(l > r), nil
+	case ">=":
+		return exprBool(l >= r), nil
+	case "&&", "||":
+		return exprBool(r != 0), nil
+	}
+
+	return 0, fmt.Errorf("unknown operator %q", b.op)
+}
+
+type exprCall struct {
+	fn   string
+	args []node
+}
+
+var exprFunctions = map[string]struct {
+	args int
+	fn   func(a []float64) float64
+}{
+	"abs":   {1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"min":   {2, func(a []float64) float64 { return math.Min(a[0], a[1]) }},
+	"max":   {2, func(a []float64) float64 { return math.Max(a[0], a[1]) }},
+	"clamp": {3, func(a []float64) float64 { return math.Max(a[1], math.Min(a[0], a[2])) }},
+	"bit":   {2, func(a []float64) float64 { return exprBool(uint64(a[0])&uint64(a[1]) != 0) }},
+}
+
+func (c exprCall) eval(lookup Lookup) (float64, error) {
+	// if only evaluates the branch it takes
+	if c.fn == "if" {
+		cond, err := c.args[0].eval(lookup)
+		if err != nil {
+			return 0, err
+		}
+		if cond != 0 {
+			return c.args[1].eval(lookup)
+		}
+		return c.args[2].eval(lookup)
+	}
+
+	args := make([]float64, len(c.args))
+	for i, a := range c.args {
+		v, err := a.eval(lookup)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = v
+	}
+
+	return exprFunctions[c.fn].fn(args), nil
+}
+
+func exprBool(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func tokenizeExpression(s string) []string {
+	var tokens []string
+	rs := []rune(s)
+
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"' || r == '\'':
+			// Labels keep a leading " to tell them from names
+			j := i + 1
+			for j < len(rs) && rs[j] != r {
+				j++
+			}
+			tokens = append(tokens, `"`+string(rs[i+1:j]))
+			i = j + 1
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.':
+			j := i
+			for j < len(rs) && (unicode.IsLetter(rs[j]) || unicode.IsDigit(rs[j]) || rs[j] == '_' || rs[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, string(rs[i:j]))
+			i = j
+		default:
+			if i+1 < len(rs) {
+				switch two := string(rs[i : i+2]); two {
+				case "==", "!=", "<=", ">=", "&&", "||":
+					tokens = append(tokens, two)
+					i += 2
+					continue
+				}
+			}
+			tokens = append(tokens, string(r))
+			i++
+		}
+	}
+
+	return tokens
+}
+
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *exprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *exprParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *exprParser) expect(t string) error {
+	if got := p.next(); got != t {
+		return fmt.Errorf("expected %q, got %q", t, got)
+	}
+	return nil
+}
+
+func (p *exprParser) parseBinary(ops []string, operand func() (node, error)) (node, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op := p.peek()
+		found := false
+		for _, o := range ops {
+			if o == op {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return left, nil
+		}
+
+		p.next()
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+
+		left = exprBinary{op: op, left: left, right: right}
+	}
+}
+
+func (p *exprParser) parseOr() (node, error) {
+	return p.parseBinary([]string{"||"}, p.parseAnd)
+}
+
+func (p *exprParser) parseAnd() (node, error) {
+	return p.parseBinary([]string{"&&"}, p.parseComparison)
+}
+
+func (p *exprParser) parseComparison() (node, error) {
+	return p.parseBinary([]string{"==", "!=", "<", "<=", ">", ">="}, p.parseAdditive)
+}
+
+func (p *exprParser) parseAdditive() (node, error) {
+	return p.parseBinary([]string{"+", "-"}, p.parseMultiplicative)
+}
+
+func (p *exprParser) parseMultiplicative() (node, error) {
+	return p.parseBinary([]string{"*", "/"}, p.parseUnary)
+}
+
+func (p *exprParser) parseUnary() (node, error) {
+	if op := p.peek(); op == "-" || op == "!" {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return exprUnary{op: op, operand: operand}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (node, error) {
+	t := p.next()
+
+	switch {
+	case t == "":
+		return nil, errors.New("unexpected end of expression")
+	case t == "(":
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return n, p.expect(")")
+	case t[0] == '"':
+		return exprLabel(t[1:]), nil
+	case t == "{":
+		n := p.next()
+		if !isName(n) {
+			return nil, fmt.Errorf("expected a name, got %q", n)
+		}
+		return exprName(n), p.expect("}")
+	case unicode.IsDigit(rune(t[0])):
+		v, err := parseNumber(t)
+		if err != nil {
+			return nil, err
+		}
+		return exprNumber(v), nil
+	case isName(t):
+		if p.peek() != "(" {
+			return exprName(t), nil
+		}
+		return p.parseCall(t)
+	}
+
+	return nil, fmt.Errorf("unexpected %q", t)
+}
+
+func (p *exprParser) parseCall(fn string) (node, error) {
+	want := 3
+	if fn != "if" {
+		f, isa := exprFunctions[fn]
+		if !isa {
+			return nil, fmt.Errorf("unknown function %q", fn)
+		}
+		want = f.args
+	}
+
+	p.next()
+
+	c := exprCall{fn: fn}
+	for p.peek() != ")" {
+		if len(c.args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		c.args = append(c.args, arg)
+	}
+	p.next()
+
+	if len(c.args) != want {
+		return nil, fmt.Errorf("%s takes %d arguments, got %d", fn, want, len(c.args))
+	}
+
+	return c, nil
+}
+
+func isName(t string) bool {
+	return t != "" && (unicode.IsLetter(rune(t[0])) || t[0] == '_')
+}
+
+func parseNumber(t string) (float64, error) {
+	if strings.HasPrefix(t, "0x") || strings.HasPrefix(t, "0X") {
+		v, err := strconv.ParseUint(t[2:], 16, 64)
+		return float64(v), err
+	}
+	return strconv.ParseFloat(t, 64)
+}
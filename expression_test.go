@@ -0,0 +1,107 @@
+package sungrow_test
+
+import (
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpression(t *testing.T) {
+	values := map[string]float64{
+		"meter_power":                     -1500,
+		"load_power":                      600,
+		"running_state":                   0x03,
+		"holding.power_limitation_switch": 0xAA,
+	}
+
+	lookup := func(name string) (float64, bool) {
+		v, isa := values[name]
+		return v, isa
+	}
+
+	tests := map[string]float64{
+		"1 + 2 * 3":                                 7,
+		"(1 + 2) * 3":                               9,
+		"-meter_power / 3":                          500,
+		"max(-meter_power, 0)":                      1500,
+		"max(meter_power, 0)":                       0,
+		"min(1, 2) + abs(-3)":                       4,
+		"clamp(150, 0, 100)":                        100,
+		"0x10 + 1":                                  17,
+		"load_power > 500":                          1,
+		"load_power > 500 && 0":                     0,
+		"0 || load_power":                           1,
+		"!(load_power == 600)":                      0,
+		"bit(running_state, 0x02)":                  1,
+		"bit(running_state, 0x04)":                  0,
+		"if(0, missing, 42)":                        42,
+		"0 && missing":                              0,
+		"{holding.power_limitation_switch} == 0xAA": 1,
+	}
+
+	for expr, expected := range tests {
+		t.Run(expr, func(t *testing.T) {
+			requires := require.New(t)
+			e, err := sungrow.ParseExpression(expr)
+			requires.NoError(err)
+			v, err := e.Eval(lookup)
+			requires.NoError(err)
+			requires.Equal(expected, v)
+		})
+	}
+
+	e, err := sungrow.ParseExpression("load_power / (1 - 1)")
+	require.NoError(t, err)
+	_, err = e.Eval(lookup)
+	require.Error(t, err)
+
+	e, err = sungrow.ParseExpression("missing + 1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"missing"}, e.Names())
+	_, err = e.Eval(lookup)
+	require.ErrorIs(t, err, sungrow.ErrMissing)
+
+	for _, bad := range []string{"1 +", "max(1)", "nope(1)", "(1", "1 2", "{1}", "inptu.load_power + 1"} {
+		_, err := sungrow.ParseExpression(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestExpressionLabels(t *testing.T) {
+	requires := require.New(t)
+
+	labels := func(name, label string) (float64, bool) {
+		if name == "grid_state" && label == "off-grid" {
+			return 0xAA, true
+		}
+		return 0, false
+	}
+	lookup := func(name string) (float64, bool) {
+		return 0xAA, name == "grid_state"
+	}
+
+	for formula, want := range map[string]float64{
+		`grid_state == "off-grid"`:               1,
+		`'off-grid' != grid_state`:               0,
+		`grid_state == "off-grid" && 1 + 1 == 2`: 1,
+	} {
+		expr, err := sungrow.ParseExpressionLabels(formula, labels)
+		requires.NoError(err, formula)
+		v, err := expr.Eval(lookup)
+		requires.NoError(err, formula)
+		requires.Equal(want, v, formula)
+	}
+
+	for _, formula := range []string{
+		`grid_state == "on-grid"`,
+		`grid_state > "off-grid"`,
+		`"off-grid"`,
+	} {
+		_, err := sungrow.ParseExpressionLabels(formula, labels)
+		requires.Error(err, formula)
+	}
+
+	_, err := sungrow.ParseExpression(`grid_state == "off-grid"`)
+	requires.Error(err)
+}
@@ -0,0 +1,181 @@
+package sungrow
+
+import (
+	"fmt"
+	"time"
+)
+
+// Severity of a fault, as the inverter treats it
+type Severity string
+
+const (
+	// Generation stops until the condition clears
+	SeverityFault Severity = "fault"
+	// Generation stops until someone intervenes
+	SeverityPermanent Severity = "permanent"
+	// Generation continues
+	SeverityAlarm Severity = "alarm"
+	// Not in the catalogue
+	SeverityUnknown Severity = "unknown"
+)
+
+// FaultDefinition is an entry in a fault catalogue.
+type FaultDefinition struct {
+	Code        int      `yaml:"code"`
+	Description string   `yaml:"description"`
+	Severity    Severity `yaml:"severity,omitempty"`
+	Action      string   `yaml:"action,omitempty"`
+	Models      Models   `yaml:"models,omitempty"`
+}
+
+// FaultCatalogue describes the codes a fault register can hold, for bitmask
+// catalogues each code is a bit and several can be set at once.
+type FaultCatalogue struct {
+	Bitmask bool `yaml:"bitmask,omitempty"`
+	// Severity of codes that don't specify one, defaults to fault
+	Severity Severity          `yaml:"severity,omitempty"`
+	Codes    []FaultDefinition `yaml:"codes"`
+}
+
+// Fault is a decoded fault register value.
+type Fault struct {
+	Code        int
+	Description string
+	Severity    Severity
+	Action      string
+}
+
+func (f Fault) String() string {
+	return fmt.Sprintf("%s (%d)", f.Description, f.Code)
+}
+
+// FaultRecord is the last fault along with when it happened.
+type FaultRecord struct {
+	Time  time.Time
+	Fault Fault
+}
+
+// Lookup finds the definition for a code, preferring one specific to the model.
+func (c *FaultCatalogue) Lookup(code int, model string) Fault {
+	var found *FaultDefinition
+	for idx := range c.Codes {
+		d := &c.Codes[idx]
+		if d.Code != code || (model != "" && !d.Models.ContainsOrNull(model)) {
+			continue
+		}
+
+		if found == nil || len(d.Models) > 0 {
+			found = d
+		}
+	}
+
+	if found == nil {
+		f := Fault{
+			Code:        code,
+			Description: fmt.Sprintf("Unknown fault %d", code),
+			Severity:    SeverityUnknown,
+		}
+
+		// The register still says what kind of bit it is
+		if c.Bitmask {
+			f.Description = fmt.Sprintf("Unknown fault bit 0x%X", code)
+			f.Severity = c.severity()
+		}
+
+		return f
+	}
+
+	severity := found.Severity
+	if severity == "" {
+		severity = c.severity()
+	}
+
+	return Fault{
+		Code:        code,
+		Description: found.Description,
+		Severity:    severity,
+		Action:      found.Action,
+	}
+}
+
+func (c *FaultCatalogue) severity() Severity {
+	if c.Severity == "" {
+		return SeverityFault
+	}
+	return c.Severity
+}
+
+// decode turns a raw register value into a Fault, nil when there's no fault,
+// or a []Fault for bitmask catalogues.
+func (c *FaultCatalogue) decode(raw uint32, model string) interface{} {
+	if !c.Bitmask {
+		if raw == 0 {
+			return nil
+		}
+		return c.Lookup(int(raw), model)
+	}
+
+	faults := []Fault{}
+	for bit := uint32(1); bit != 0; bit <<= 1 {
+		if raw&bit != 0 {
+			faults = append(faults, c.Lookup(int(bit), model))
+		}
+	}
+
+	return faults
+}
+
+// resolveFaults links registers to the catalogue they name.
+func (i *Inverter) resolveFaults() error {
+	for _, set := range [][]Register{i.Registers.Input, i.Registers.Holding} {
+		for idx := range set {
+			r := &set[idx]
+			if r.Faults == "" {
+				continue
+			}
+
+			c, isa := i.Faults[r.Faults]
+			if !isa {
+				return fmt.Errorf("register %q refers to unknown fault catalogue %q", r.Name, r.Faults)
+			}
+
+			r.catalogue = c
+		}
+	}
+
+	return nil
+}
+
+// LastFault combines fault_code with the fault_year through fault_second
+// registers from the last read, the time is in the inverter's local time.
+// It returns false when there's no fault.
+func (i *Inverter) LastFault(loc *time.Location) (*FaultRecord, bool) {
+	var fault *Fault
+	for _, r := range i.Registers.Input {
+		if r.Name != "fault_code" || !r.Supported || r.Err != nil {
+			continue
+		}
+
+		if f, isa := r.Value.(Fault); isa {
+			fault = &f
+		}
+	}
+
+	if fault == nil {
+		return nil, false
+	}
+
+	var parts [6]int
+	for idx, name := range []string{"fault_year", "fault_month", "fault_day", "fault_hour", "fault_minute", "fault_second"} {
+		if v, isa := i.Value("input." + name); isa {
+			parts[idx] = int(v)
+		}
+	}
+
+	record := &FaultRecord{Fault: *fault}
+	if parts[0] != 0 {
+		record.Time = time.Date(parts[0], time.Month(parts[1]), parts[2], parts[3], parts[4], parts[5], 0, loc)
+	}
+
+	return record, true
+}
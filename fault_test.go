@@ -0,0 +1,151 @@
+package sungrow_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/internal/modbustest"
+	"github.com/stretchr/testify/require"
+)
+
+const faultRegisters = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0x2433: "SG10RT"
+        0xE03:
+          hybrid: true
+          name: "SH10RT"
+    - address: 5039
+      name: "fault_year"
+    - address: 5040
+      name: "fault_month"
+    - address: 5041
+      name: "fault_day"
+    - address: 5042
+      name: "fault_hour"
+    - address: 5043
+      name: "fault_minute"
+    - address: 5044
+      name: "fault_second"
+    - address: 5045
+      name: "fault_code"
+      faults: "inverter"
+    - address: 13052
+      name: "grid_side_fault"
+      type: "uint32"
+      faults: "grid"
+faults:
+  inverter:
+    codes:
+      - code: 2
+        description: "Grid overvoltage"
+        action: "Check the grid voltage"
+      - code: 70
+        description: "Fan alarm"
+        severity: "alarm"
+      - code: 70
+        description: "Fan 2 alarm"
+        severity: "alarm"
+        models: ["SG10RT"]
+  grid:
+    bitmask: true
+    codes:
+      - code: 0x0002
+        description: "Grid undervoltage"
+`
+
+func readFaults(t *testing.T, code uint16, grid uint16) *sungrow.Inverter {
+	var inv sungrow.Inverter
+	require.NoError(t, inv.Define(strings.NewReader(faultRegisters)))
+
+	require.NoError(t, inv.Read(&modbustest.Device{
+		Input: map[uint16]uint16{
+			4999:  0x2433,
+			5038:  2023,
+			5039:  11,
+			5040:  4,
+			5041:  13,
+			5042:  37,
+			5043:  5,
+			5044:  code,
+			13051: grid,
+			13052: 0,
+		},
+	}))
+
+	return &inv
+}
+
+func TestFaults(t *testing.T) {
+	requires := require.New(t)
+
+	inv := readFaults(t, 2, 0x0003)
+
+	requires.Equal(sungrow.Fault{
+		Code:        2,
+		Description: "Grid overvoltage",
+		Severity:    sungrow.SeverityFault,
+		Action:      "Check the grid voltage",
+	}, inv.Registers.Input[7].Value)
+
+	requires.Equal([]sungrow.Fault{
+		{Code: 1, Description: "Unknown fault bit 0x1", Severity: sungrow.SeverityFault},
+		{Code: 2, Description: "Grid undervoltage", Severity: sungrow.SeverityFault},
+	}, inv.Registers.Input[8].Value)
+
+	// The raw value is still available to formulas
+	v, isa := inv.Value("fault_code")
+	requires.True(isa)
+	requires.Equal(2.0, v)
+
+	record, isa := inv.LastFault(time.UTC)
+	requires.True(isa)
+	requires.Equal(time.Date(2023, time.November, 4, 13, 37, 5, 0, time.UTC), record.Time)
+	requires.Equal("Grid overvoltage (2)", record.Fault.String())
+
+	// Model specific entries win
+	inv = readFaults(t, 70, 0)
+	requires.Equal("Fan 2 alarm", inv.Registers.Input[7].Value.(sungrow.Fault).Description)
+	requires.Equal([]sungrow.Fault{}, inv.Registers.Input[8].Value)
+
+	inv = readFaults(t, 999, 0)
+	requires.Equal(sungrow.SeverityUnknown, inv.Registers.Input[7].Value.(sungrow.Fault).Severity)
+
+	inv = readFaults(t, 0, 0)
+	requires.Nil(inv.Registers.Input[7].Value)
+	_, isa = inv.LastFault(time.UTC)
+	requires.False(isa)
+
+	var broken sungrow.Inverter
+	requires.Error(broken.Define(strings.NewReader("registers:\n  input:\n    - address: 1\n      faults: nope\n")))
+}
+
+func TestBuiltInFaults(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.DefineDefault())
+
+	// pid_alarm_code keeps decoding to its label
+	found := false
+	for _, r := range inv.Registers.Input {
+		if r.Name != "pid_alarm_code" {
+			continue
+		}
+		found = true
+
+		requires.NoError(r.Decode([]byte{0x01, 0xB0}))
+		requires.Equal("PID resistance abnormal", r.Value)
+	}
+
+	code := inv.Registers.Find("fault_code", "SG5KTL-MT")
+	requires.NotNil(code)
+	requires.NoError(code.Decode([]byte{0x00, 0x02}))
+	requires.Equal("Grid overvoltage", code.Value.(sungrow.Fault).Description)
+
+	requires.True(found)
+}
@@ -0,0 +1,124 @@
+package fleet
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultInterval = 30 * time.Second
+)
+
+// DefaultAggregates are summed across devices when the config doesn't say.
+var DefaultAggregates = []string{
+	"total_active_power",
+	"total_dc_power",
+	"daily_pv_generation",
+	"total_pv_generation",
+	"daily_power_yields",
+	"total_power_yields",
+}
+
+// Config is the YAML fleet configuration, eg
+//
+//	registers: sungrow.yml
+//	interval: 30s
+//	devices:
+//	  - name: home
+//	    site: home
+//	    uri: tcp://192.168.1.20
+//	  - name: shed
+//	    site: farm
+//	    uri: winet://10.0.0.5?rate=2&deadline=20s&breaker=5
+//	    interval: 1m
+//	    request_interval: 200ms
+type Config struct {
+	// Register definition layered over the built in map for the device,
+	// used by devices that don't have their own
+	Registers string        `yaml:"registers"`
+	Interval  time.Duration `yaml:"interval,omitempty"`
+	// Registers summed per site and across the fleet
+	Aggregate []string       `yaml:"aggregate,omitempty"`
+	Devices   []DeviceConfig `yaml:"devices"`
+
+	dir string
+}
+
+// DeviceConfig describes one inverter.
+type DeviceConfig struct {
+	Name string `yaml:"name"`
+	Site string `yaml:"site,omitempty"`
+	// Connection string, see transport.Dial for the rate limits and timeouts
+	URI       string        `yaml:"uri"`
+	Registers string        `yaml:"registers,omitempty"`
+	Interval  time.Duration `yaml:"interval,omitempty"`
+	// Least time between requests to the device
+	RequestInterval time.Duration `yaml:"request_interval,omitempty"`
+	// Only read these registers, everything if empty
+	Only []string `yaml:"only,omitempty"`
+}
+
+// LoadConfig reads a configuration, relative register paths are resolved against dir.
+func LoadConfig(r io.Reader, dir string) (*Config, error) {
+	var cfg Config
+	if err := yaml.NewDecoder(r).Decode(&cfg); err != nil {
+		return nil, err
+	}
+
+	cfg.dir = dir
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultInterval
+	}
+
+	if len(cfg.Aggregate) == 0 {
+		cfg.Aggregate = DefaultAggregates
+	}
+
+	seen := map[string]bool{}
+	for i, d := range cfg.Devices {
+		switch {
+		case d.Name == "":
+			return nil, fmt.Errorf("device %d has no name", i)
+		case seen[d.Name]:
+			return nil, fmt.Errorf("device %q is listed twice", d.Name)
+		case d.URI == "":
+			return nil, fmt.Errorf("device %q has no uri", d.Name)
+		}
+		seen[d.Name] = true
+
+		if d.Site == "" {
+			cfg.Devices[i].Site = d.Name
+		}
+
+		if d.Interval <= 0 {
+			cfg.Devices[i].Interval = cfg.Interval
+		}
+	}
+
+	return &cfg, nil
+}
+
+// LoadConfigFile reads a configuration file.
+func LoadConfigFile(name string) (*Config, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return LoadConfig(f, filepath.Dir(name))
+}
+
+func (c *Config) path(name string) string {
+	if name == "" || filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(c.dir, name)
+}
@@ -0,0 +1,433 @@
+// Package fleet polls many inverters concurrently and aggregates their values.
+package fleet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/transport"
+)
+
+// Intervals a device can miss before its values are left out of the totals
+const staleIntervals = 3
+
+// Snapshot is the last read of a device.
+type Snapshot struct {
+	Name        string             `json:"name"`
+	Site        string             `json:"site"`
+	Model       string             `json:"model,omitempty"`
+	Transport   string             `json:"transport,omitempty"`
+	LastPoll    time.Time          `json:"last_poll,omitempty"`
+	LastSuccess time.Time          `json:"last_success,omitempty"`
+	Error       string             `json:"error,omitempty"`
+	Stale       bool               `json:"stale"`
+	Values      map[string]float64 `json:"values,omitempty"`
+	Labels      map[string]string  `json:"labels,omitempty"`
+}
+
+// Totals are the aggregated values of a site, or the whole fleet.
+type Totals struct {
+	Site string `json:"site,omitempty"`
+	// Devices that contributed
+	Devices []string `json:"devices"`
+	// Devices left out because they're stale
+	Missing []string           `json:"missing,omitempty"`
+	Values  map[string]float64 `json:"values"`
+}
+
+// Manager polls the devices in a configuration.
+type Manager struct {
+	Config *Config
+	// Opens a device's connection, defaults to transport.Dial
+	Dial   func(uri string) (sungrow.Modbus, error)
+	Logger *log.Logger
+
+	devices []*device
+}
+
+type device struct {
+	cfg        DeviceConfig
+	definition *sungrow.Inverter
+	// The device picks the built in map on the first poll
+	detect bool
+	only   map[string]bool
+
+	mu       sync.Mutex
+	snapshot Snapshot
+}
+
+// NewManager loads every device's register definition.
+func NewManager(cfg *Config) (*Manager, error) {
+	m := &Manager{
+		Config: cfg,
+		Dial: func(uri string) (sungrow.Modbus, error) {
+			client, err := transport.Dial(uri)
+			if err != nil {
+				return nil, err
+			}
+			return client, nil
+		},
+	}
+
+	definitions := map[string]*sungrow.Inverter{}
+	for _, dc := range cfg.Devices {
+		path := cfg.path(dc.Registers)
+		if path == "" {
+			path = cfg.path(cfg.Registers)
+		}
+
+		def, isa := definitions[path]
+		if !isa {
+			def = &sungrow.Inverter{}
+
+			if err := def.DefineDefault(path); err != nil {
+				return nil, fmt.Errorf("device %q: %w", dc.Name, err)
+			}
+			definitions[path] = def
+		}
+
+		d := &device{
+			cfg:        dc,
+			definition: def,
+			detect:     true,
+			snapshot:   Snapshot{Name: dc.Name, Site: dc.Site, Stale: true},
+		}
+
+		if len(dc.Only) > 0 {
+			// The model has to be known to pick the right registers
+			d.only = map[string]bool{"device_type_code": true}
+			for _, name := range dc.Only {
+				d.only[name] = true
+			}
+		}
+
+		m.devices = append(m.devices, d)
+	}
+
+	return m, nil
+}
+
+// Run polls every device at its interval until the context is done.
+func (m *Manager) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for _, d := range m.devices {
+		wg.Add(1)
+		go func(d *device) {
+			defer wg.Done()
+			m.run(ctx, d)
+		}(d)
+	}
+
+	wg.Wait()
+}
+
+func (m *Manager) run(ctx context.Context, d *device) {
+	var client sungrow.Modbus
+
+	defer func() {
+		if closer, isa := client.(io.Closer); isa {
+			closer.Close()
+		}
+	}()
+
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if client == nil {
+			c, err := m.Dial(d.cfg.URI)
+			if err != nil {
+				m.logf("fleet: %s: %v", d.cfg.Name, err)
+				d.failed(time.Now(), err)
+			} else {
+				client = newThrottle(c, d.cfg.RequestInterval)
+			}
+		}
+
+		if client != nil {
+			m.poll(d, client, time.Now())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll reads every device once, concurrently, using the clients given by name.
+func (m *Manager) Poll(clients map[string]sungrow.Modbus, now time.Time) {
+	var wg sync.WaitGroup
+
+	for _, d := range m.devices {
+		client, isa := clients[d.cfg.Name]
+		if !isa {
+			continue
+		}
+
+		wg.Add(1)
+		go func(d *device, client sungrow.Modbus) {
+			defer wg.Done()
+			m.poll(d, client, now)
+		}(d, client)
+	}
+
+	wg.Wait()
+}
+
+func (m *Manager) poll(d *device, client sungrow.Modbus, now time.Time) {
+	if d.detect {
+		def := d.definition.Clone()
+		if err := def.Detect(client); err != nil {
+			m.logf("fleet: %s: %v", d.cfg.Name, err)
+			d.failed(now, err)
+			return
+		}
+		d.definition, d.detect = def, false
+	}
+
+	inv := d.definition.Clone()
+
+	err := inv.ReadWithSkip(client, func(r sungrow.Register, funcCode int) bool {
+		return d.only != nil && !d.only[r.Name]
+	})
+	if err != nil {
+		m.logf("fleet: %s: %v", d.cfg.Name, err)
+		d.failed(now, err)
+		return
+	}
+
+	s := Snapshot{
+		Name:        d.cfg.Name,
+		Site:        d.cfg.Site,
+		Model:       inv.Model(),
+		LastPoll:    now,
+		LastSuccess: now,
+		Values:      map[string]float64{},
+		Labels:      map[string]string{},
+	}
+
+	for _, r := range inv.Registers.All() {
+		if !r.Supported || r.Err != nil {
+			continue
+		}
+
+		if t := r.Transport; t != "" {
+			s.Transport = t
+		}
+
+		if _, seen := s.Values[r.Name]; seen {
+			continue
+		}
+
+		if v, isa := r.Float(); isa {
+			s.Values[r.Name] = v
+		}
+
+		switch x := r.Value.(type) {
+		case string:
+			s.Labels[r.Name] = x
+		case fmt.Stringer:
+			s.Labels[r.Name] = x.String()
+		}
+	}
+
+	d.mu.Lock()
+	d.snapshot = s
+	d.mu.Unlock()
+}
+
+func (d *device) failed(now time.Time, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.snapshot.LastPoll = now
+	d.snapshot.Error = err.Error()
+}
+
+func (d *device) get(now time.Time) Snapshot {
+	d.mu.Lock()
+	s := d.snapshot
+	d.mu.Unlock()
+
+	s.Stale = s.LastSuccess.IsZero() || now.Sub(s.LastSuccess) > staleIntervals*d.cfg.Interval
+	return s
+}
+
+// Devices returns a snapshot of every device, in configuration order.
+func (m *Manager) Devices(now time.Time) []Snapshot {
+	snapshots := make([]Snapshot, len(m.devices))
+	for i, d := range m.devices {
+		snapshots[i] = d.get(now)
+	}
+	return snapshots
+}
+
+// Device returns the snapshot of the named device.
+func (m *Manager) Device(name string, now time.Time) (Snapshot, bool) {
+	for _, d := range m.devices {
+		if d.cfg.Name == name {
+			return d.get(now), true
+		}
+	}
+	return Snapshot{}, false
+}
+
+// Sites returns the names of the sites.
+func (m *Manager) Sites() []string {
+	seen := map[string]bool{}
+	var sites []string
+	for _, d := range m.devices {
+		if !seen[d.cfg.Site] {
+			seen[d.cfg.Site] = true
+			sites = append(sites, d.cfg.Site)
+		}
+	}
+	sort.Strings(sites)
+	return sites
+}
+
+// Totals sums the aggregate registers over the site's fresh devices, or the
+// whole fleet's when site is empty.
+func (m *Manager) Totals(site string, now time.Time) Totals {
+	t := Totals{Site: site, Devices: []string{}, Values: map[string]float64{}}
+
+	for _, d := range m.devices {
+		if site != "" && d.cfg.Site != site {
+			continue
+		}
+
+		s := d.get(now)
+		if s.Stale {
+			t.Missing = append(t.Missing, s.Name)
+			continue
+		}
+
+		t.Devices = append(t.Devices, s.Name)
+		for _, name := range m.Config.Aggregate {
+			if v, isa := s.Values[name]; isa {
+				t.Values[name] += v
+			}
+		}
+	}
+
+	return t
+}
+
+// ServeHTTP publishes the snapshots and totals as JSON on
+//
+//	/devices          every device
+//	/devices/{name}   one device
+//	/sites            the totals of every site
+//	/sites/{site}     one site's totals
+//	/totals           the fleet's totals
+func (m *Manager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	now := time.Now()
+	parts := strings.SplitN(strings.Trim(r.URL.Path, "/"), "/", 2)
+
+	var v interface{}
+	switch {
+	case parts[0] == "devices" && len(parts) == 1:
+		v = m.Devices(now)
+	case parts[0] == "devices":
+		s, found := m.Device(parts[1], now)
+		if !found {
+			http.NotFound(w, r)
+			return
+		}
+		v = s
+	case parts[0] == "sites" && len(parts) == 1:
+		var totals []Totals
+		for _, site := range m.Sites() {
+			totals = append(totals, m.Totals(site, now))
+		}
+		v = totals
+	case parts[0] == "sites":
+		t := m.Totals(parts[1], now)
+		if len(t.Devices) == 0 && len(t.Missing) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+		v = t
+	case parts[0] == "totals" && len(parts) == 1:
+		v = m.Totals("", now)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func (m *Manager) logf(format string, v ...interface{}) {
+	if m.Logger != nil {
+		m.Logger.Printf(format, v...)
+	}
+}
+
+// throttle spaces out the requests to a device
+type throttle struct {
+	sungrow.Modbus
+	interval time.Duration
+
+	mu   sync.Mutex
+	last time.Time
+}
+
+func newThrottle(client sungrow.Modbus, interval time.Duration) sungrow.Modbus {
+	if interval <= 0 {
+		return client
+	}
+	return &throttle{Modbus: client, interval: interval}
+}
+
+func (t *throttle) wait() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if d := t.interval - time.Since(t.last); d > 0 {
+		time.Sleep(d)
+	}
+	t.last = time.Now()
+}
+
+func (t *throttle) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	t.wait()
+	return t.Modbus.ReadInputRegisters(address, quantity)
+}
+
+func (t *throttle) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	t.wait()
+	return t.Modbus.ReadHoldingRegisters(address, quantity)
+}
+
+func (t *throttle) Transport() string {
+	if tr, isa := t.Modbus.(sungrow.Transporter); isa {
+		return tr.Transport()
+	}
+	return ""
+}
+
+func (t *throttle) Close() error {
+	if closer, isa := t.Modbus.(io.Closer); isa {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package fleet_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/fleet"
+	"github.com/goburrow/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+const registers = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0x147: "SG5KTL-MT"
+    - address: 5003
+      name: "daily_pv_generation"
+      scale: 0.1
+      unit: "kWh"
+    - address: 5031
+      name: "total_active_power"
+      type: "uint32"
+      unit: "W"
+`
+
+const config = `registers: sungrow.yml
+interval: 20ms
+aggregate:
+  - total_active_power
+  - daily_pv_generation
+devices:
+  - name: house
+    site: home
+    uri: tcp://house
+  - name: shed
+    site: home
+    uri: tcp://shed
+    request_interval: 1ms
+    only: [total_active_power, daily_pv_generation]
+  - name: barn
+    site: farm
+    uri: tcp://barn
+`
+
+type fakeInverter struct {
+	input map[uint16]uint16
+	// Reads block until closed when set
+	hang chan struct{}
+}
+
+func (f *fakeInverter) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	if f.hang != nil {
+		<-f.hang
+		return nil, errors.New("i/o timeout")
+	}
+
+	var results []byte
+	for i := uint16(0); i < quantity; i++ {
+		v, isa := f.input[address+i]
+		if !isa {
+			return nil, &modbus.ModbusError{FunctionCode: 0x84, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}
+		}
+		results = append(results, byte(v>>8), byte(v))
+	}
+	return results, nil
+}
+
+func (f *fakeInverter) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return nil, &modbus.ModbusError{FunctionCode: 0x83, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}
+}
+
+func generating(power uint32, daily uint16) *fakeInverter {
+	return &fakeInverter{input: map[uint16]uint16{
+		4999: 0x147,
+		5002: daily,
+		5030: uint16(power),
+		5031: uint16(power >> 16),
+	}}
+}
+
+func loadConfig(t *testing.T, config string) *fleet.Config {
+	requires := require.New(t)
+
+	dir := t.TempDir()
+	requires.NoError(os.WriteFile(filepath.Join(dir, "sungrow.yml"), []byte(registers), 0o644))
+
+	cfg, err := fleet.LoadConfig(strings.NewReader(config), dir)
+	requires.NoError(err)
+
+	return cfg
+}
+
+func TestLoadConfig(t *testing.T) {
+	requires := require.New(t)
+
+	cfg := loadConfig(t, config)
+	requires.Len(cfg.Devices, 3)
+	requires.Equal(20*time.Millisecond, cfg.Devices[0].Interval)
+	requires.Equal(time.Millisecond, cfg.Devices[1].RequestInterval)
+
+	_, err := fleet.LoadConfig(strings.NewReader("registers: x.yml\ndevices:\n  - name: a\n    uri: tcp://a\n  - name: a\n    uri: tcp://b\n"), "")
+	requires.Error(err)
+
+	// Devices without a definition use the built in maps
+	builtin, err := fleet.LoadConfig(strings.NewReader("devices:\n  - name: a\n    uri: tcp://a\n"), "")
+	requires.NoError(err)
+	_, err = fleet.NewManager(builtin)
+	requires.NoError(err)
+}
+
+func TestDefaultAggregates(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.DefineDefault())
+
+	names := map[string]bool{}
+	for _, regs := range [][]sungrow.Register{inv.Registers.Input, inv.Registers.Holding, inv.Registers.Computed} {
+		for _, r := range regs {
+			names[r.Name] = true
+		}
+	}
+
+	for _, name := range fleet.DefaultAggregates {
+		requires.True(names[name], "%s isn't in the built in map", name)
+	}
+}
+
+func TestPollAndTotals(t *testing.T) {
+	requires := require.New(t)
+
+	m, err := fleet.NewManager(loadConfig(t, config))
+	requires.NoError(err)
+
+	now := time.Now()
+	m.Poll(map[string]sungrow.Modbus{
+		"house": generating(3000, 125),
+		"shed":  generating(70000, 5),
+		"barn":  generating(1500, 80),
+	}, now)
+
+	house, found := m.Device("house", now)
+	requires.True(found)
+	requires.False(house.Stale)
+	requires.Equal("SG5KTL-MT", house.Model)
+	requires.Equal(3000.0, house.Values["total_active_power"])
+	requires.InDelta(12.5, house.Values["daily_pv_generation"], 0.001)
+	// The definition is layered over the built in map
+	requires.InDelta(12.5, house.Values["daily_power_yields"], 0.001)
+
+	home := m.Totals("home", now)
+	requires.Equal([]string{"house", "shed"}, home.Devices)
+	requires.Equal(73000.0, home.Values["total_active_power"])
+	requires.InDelta(13.0, home.Values["daily_pv_generation"], 0.001)
+
+	all := m.Totals("", now)
+	requires.Equal(74500.0, all.Values["total_active_power"])
+
+	// The barn stops answering, it drops out of the totals once stale
+	later := now.Add(time.Second)
+	m.Poll(map[string]sungrow.Modbus{
+		"house": generating(3000, 125),
+		"shed":  generating(70000, 5),
+	}, later)
+
+	all = m.Totals("", later)
+	requires.Equal([]string{"house", "shed"}, all.Devices)
+	requires.Equal([]string{"barn"}, all.Missing)
+	requires.Equal(73000.0, all.Values["total_active_power"])
+
+	requires.Equal([]string{"farm", "home"}, m.Sites())
+}
+
+func TestUnreachableDoesNotStall(t *testing.T) {
+	requires := require.New(t)
+
+	m, err := fleet.NewManager(loadConfig(t, config))
+	requires.NoError(err)
+
+	hang := make(chan struct{})
+	var once sync.Once
+	release := func() { once.Do(func() { close(hang) }) }
+	defer release()
+
+	m.Dial = func(uri string) (sungrow.Modbus, error) {
+		switch uri {
+		case "tcp://house":
+			return generating(3000, 125), nil
+		case "tcp://shed":
+			return generating(2000, 50), nil
+		}
+		return &fakeInverter{hang: hang}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		m.Run(ctx)
+		close(done)
+	}()
+
+	requires.Eventually(func() bool {
+		return m.Totals("home", time.Now()).Values["total_active_power"] == 5000
+	}, time.Second, 5*time.Millisecond)
+
+	barn, _ := m.Device("barn", time.Now())
+	requires.True(barn.Stale)
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/totals", nil))
+	requires.Equal(200, rec.Code)
+
+	var totals fleet.Totals
+	requires.NoError(json.NewDecoder(rec.Body).Decode(&totals))
+	requires.Equal([]string{"barn"}, totals.Missing)
+	requires.Equal(5000.0, totals.Values["total_active_power"])
+
+	rec = httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/devices/nope", nil))
+	requires.Equal(404, rec.Code)
+
+	cancel()
+	release()
+	<-done
+}
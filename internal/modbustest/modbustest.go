@@ -0,0 +1,86 @@
+// Package modbustest has a fake device for tests, serving registers from
+// maps of address to value.
+package modbustest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/goburrow/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+// Device serves registers from maps of address, as on the wire, to value.
+// Like the real thing it refuses any block that touches an address it
+// doesn't have.
+type Device struct {
+	Input   map[uint16]uint16
+	Holding map[uint16]uint16
+	// Holding registers that accept writes but don't change
+	Ignored map[uint16]bool
+	// Reported as the transport
+	Name string
+	// Requests made
+	Reads  int
+	Writes int
+}
+
+func (d *Device) read(regs map[uint16]uint16, funcCode byte, address, quantity uint16) ([]byte, error) {
+	d.Reads++
+
+	var results []byte
+	for i := uint16(0); i < quantity; i++ {
+		v, isa := regs[address+i]
+		if !isa {
+			return nil, &modbus.ModbusError{FunctionCode: funcCode | 0x80, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}
+		}
+		results = append(results, byte(v>>8), byte(v))
+	}
+	return results, nil
+}
+
+func (d *Device) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	return d.read(d.Input, modbus.FuncCodeReadInputRegisters, address, quantity)
+}
+
+func (d *Device) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return d.read(d.Holding, modbus.FuncCodeReadHoldingRegisters, address, quantity)
+}
+
+func (d *Device) WriteSingleRegister(address, value uint16) ([]byte, error) {
+	return d.WriteMultipleRegisters(address, 1, []byte{byte(value >> 8), byte(value)})
+}
+
+func (d *Device) WriteMultipleRegisters(address, quantity uint16, value []byte) ([]byte, error) {
+	d.Writes++
+	for i := uint16(0); i < quantity; i++ {
+		if _, isa := d.Holding[address+i]; !isa {
+			return nil, &modbus.ModbusError{FunctionCode: modbus.FuncCodeWriteMultipleRegisters | 0x80, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}
+		}
+		if !d.Ignored[address+i] {
+			d.Holding[address+i] = uint16(value[i*2])<<8 | uint16(value[i*2+1])
+		}
+	}
+	return nil, nil
+}
+
+func (d *Device) Transport() string {
+	return d.Name
+}
+
+// Set32 stores a 32 bit value in the registers at address, low word first
+// as Sungrow does.
+func Set32(regs map[uint16]uint16, address uint16, v uint32) {
+	regs[address], regs[address+1] = uint16(v), uint16(v>>16)
+}
+
+// Read defines an inverter and reads it from a device with the input registers.
+func Read(t testing.TB, definition string, input map[uint16]uint16) *sungrow.Inverter {
+	t.Helper()
+
+	var inv sungrow.Inverter
+	require.NoError(t, inv.Define(strings.NewReader(definition)))
+	require.NoError(t, inv.Read(&Device{Input: input}))
+	return &inv
+}
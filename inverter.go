@@ -10,7 +10,13 @@ import (
 )
 
 type Inverter struct {
-	Registers Registers `yaml:"registers,omitempty"`
+	Registers Registers                  `yaml:"registers,omitempty"`
+	Faults    map[string]*FaultCatalogue `yaml:"faults,omitempty"`
+
+	// Files layered over the built in map and whether the next read should
+	// detect the device to pick the map, see DefineDefault
+	overrides []string
+	detect    bool
 }
 
 type Modbus interface {
@@ -18,10 +24,25 @@ type Modbus interface {
 	ReadHoldingRegisters(address, quantity uint16) (results []byte, err error)
 }
 
+// Transporter is implemented by clients that can say which transport served
+// the last request, such as transport.Client.
+type Transporter interface {
+	Transport() string
+}
+
 func (i *Inverter) Define(r io.Reader) error {
 	i.Clear()
+	i.overrides, i.detect = nil, false
+
+	if err := yaml.NewDecoder(r).Decode(i); err != nil {
+		return err
+	}
+
+	if err := i.resolveFaults(); err != nil {
+		return err
+	}
 
-	return yaml.NewDecoder(r).Decode(i)
+	return i.validateComputed()
 }
 
 func (i *Inverter) DefineFromYaml(yamlFile string) error {
@@ -35,8 +56,18 @@ func (i *Inverter) DefineFromYaml(yamlFile string) error {
 	return i.Define(f)
 }
 
+// Clone returns a copy of the inverter that can be read independently.
+func (i *Inverter) Clone() *Inverter {
+	c := *i
+	c.Registers.Input = append([]Register(nil), i.Registers.Input...)
+	c.Registers.Holding = append([]Register(nil), i.Registers.Holding...)
+	c.Registers.Computed = append([]Register(nil), i.Registers.Computed...)
+	return &c
+}
+
 func (i *Inverter) Clear() {
 	i.Registers.Clear()
+	i.Faults = nil
 }
 
 func (i *Inverter) Read(client Modbus) error {
@@ -44,6 +75,12 @@ func (i *Inverter) Read(client Modbus) error {
 }
 
 func (i *Inverter) ReadWithSkip(client Modbus, skipFn func(r Register, funcCode int) bool) error {
+	if i.detect {
+		if err := i.Detect(client); err != nil {
+			return err
+		}
+	}
+
 	var model string
 
 	query := []struct {
@@ -65,7 +102,7 @@ func (i *Inverter) ReadWithSkip(client Modbus, skipFn func(r Register, funcCode
 
 	for _, q := range query {
 		for i, v := range q.regs {
-			if !skipFn(v, q.code) {
+			if skipFn(v, q.code) {
 				continue
 			}
 			// Model check, don't bother reading registers for models that don't support it
@@ -73,8 +110,13 @@ func (i *Inverter) ReadWithSkip(client Modbus, skipFn func(r Register, funcCode
 				continue
 			}
 			v.Supported = true
+			v.model = model
 
 			results, err := q.fn(uint16(v.Address-1), uint16(v.sizeAs16Bit()))
+			if t, isa := client.(Transporter); isa {
+				v.Transport = t.Transport()
+			}
+
 			if err != nil {
 				// Modbus/Register error...
 				if e, isa := err.(*modbus.ModbusError); isa {
@@ -89,22 +131,48 @@ func (i *Inverter) ReadWithSkip(client Modbus, skipFn func(r Register, funcCode
 
 			v.read(bytes.NewReader(results))
 
-			if model == "" && v.Address == 5000 {
-				switch x := v.Value.(type) {
-				case map[string]interface{}:
-					if s, isa := x["name"].(string); isa {
-						model = s
-					}
-				case string:
-					model = x
-				default:
-					panic(x)
-				}
+			if model == "" && q.code == modbus.FuncCodeReadInputRegisters && v.Address == deviceTypeCodeAddress {
+				model, _ = deviceModel(v.Value)
 			}
 
 			q.regs[i] = v
 		}
 	}
 
+	i.Compute()
+
 	return nil
 }
+
+// Decode returns copies of the registers wholly covered by a block of data
+// read from address (as on the wire), decoded for the model. Input registers
+// are decoded unless holding is set.
+func (i *Inverter) Decode(holding bool, address uint16, data []byte, model string) []Register {
+	regs := i.Registers.Input
+	if holding {
+		regs = i.Registers.Holding
+	}
+
+	var decoded []Register
+	for _, r := range regs {
+		if model != "" && !r.Models.ContainsOrNull(model) {
+			continue
+		}
+
+		offset := (r.Address - 1 - int(address)) * 2
+		if offset < 0 || offset+r.sizeAs16Bit()*2 > len(data) {
+			continue
+		}
+
+		r.Supported, r.Err = true, nil
+		r.model = model
+
+		if err := r.read(bytes.NewReader(data[offset : offset+r.sizeAs16Bit()*2])); err != nil {
+			r.Err = err
+		}
+
+		decoded = append(decoded, r)
+	}
+
+	return decoded
+}
@@ -0,0 +1,81 @@
+package sungrow_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/internal/modbustest"
+	"github.com/stretchr/testify/require"
+)
+
+const testRegisters = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0x2433: "SG10RT"
+        0xE03:
+          hybrid: true
+          name: "SH10RT"
+    - address: 5001
+      name: "nominal_active_power"
+      unit: "kW"
+      scale: 0.1
+    - address: 5038
+      name: "work_state"
+      models: ["SG10RT"]
+    - address: 13023
+      name: "battery_level"
+      unit: "%"
+      scale: 0.1
+      models: ["SH10RT"]
+    - address: 13100
+      name: "bms_status"
+  holding:
+    - address: 13050
+      name: "ems_mode_selection"
+`
+
+func TestInverterRead(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(testRegisters)))
+
+	client := &modbustest.Device{
+		Name: "winet",
+		Input: map[uint16]uint16{
+			4999:  0xE03,
+			5000:  100,
+			13022: 567,
+		},
+		Holding: map[uint16]uint16{
+			13049: 2,
+		},
+	}
+
+	requires.NoError(inv.Read(client))
+
+	requires.Equal("SH10RT", inv.Model())
+	requires.True(inv.Hybrid())
+
+	input := inv.Registers.Input
+	requires.Equal(map[string]interface{}{"hybrid": true, "name": "SH10RT"}, input[0].Value)
+	requires.Equal(10.0, input[1].Value)
+	requires.Equal("winet", input[1].Transport)
+
+	// Not for this model
+	requires.False(input[2].Supported)
+	requires.Empty(input[2].Transport)
+
+	requires.True(input[3].Supported)
+	requires.InDelta(56.7, input[3].Value, 0.0001)
+
+	// Exceptions are recorded against the register
+	requires.True(input[4].Supported)
+	requires.Error(input[4].Err)
+
+	requires.Equal(2.0, inv.Registers.Holding[0].Value)
+	requires.Equal("winet", inv.Registers.Holding[0].Transport)
+}
@@ -0,0 +1,247 @@
+package learn
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// edit replaces lines [line, line+remove) with insert, lines count from 0
+type edit struct {
+	line   int
+	remove int
+	insert []string
+}
+
+// Apply makes the changes to the register definition source, editing only
+// the lines of the models lists so the rest of the formatting and the
+// comments survive.
+func Apply(src []byte, changes []Change) ([]byte, error) {
+	out := src
+	// One at a time, changes can share a line
+	for _, c := range changes {
+		var doc yaml.Node
+		if err := yaml.Unmarshal(out, &doc); err != nil {
+			return nil, err
+		}
+
+		reg, err := register(&doc, c)
+		if err != nil {
+			return nil, err
+		}
+
+		models := value(reg, "models")
+		if models == nil || models.Kind != yaml.SequenceNode {
+			return nil, fmt.Errorf("%s: no models list", c)
+		}
+
+		lines := strings.SplitAfter(string(out), "\n")
+
+		var e edit
+		if c.Add {
+			e, err = add(lines, models, c.Model)
+		} else {
+			e, err = remove(lines, models, c.Model)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c, err)
+		}
+
+		tail := append([]string(nil), lines[e.line+e.remove:]...)
+		lines = append(append(lines[:e.line], e.insert...), tail...)
+		out = []byte(strings.Join(lines, ""))
+	}
+
+	// Make sure it's still a definition
+	var check yaml.Node
+	if err := yaml.Unmarshal(out, &check); err != nil {
+		return nil, fmt.Errorf("edits broke the definition: %w", err)
+	}
+
+	return out, nil
+}
+
+// register finds the mapping node of the changed register.
+func register(doc *yaml.Node, c Change) (*yaml.Node, error) {
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("empty definition")
+	}
+
+	bank := "input"
+	if c.Holding {
+		bank = "holding"
+	}
+
+	seq := value(value(doc.Content[0], "registers"), bank)
+	if seq == nil || seq.Kind != yaml.SequenceNode || c.Index >= len(seq.Content) {
+		return nil, fmt.Errorf("%s: not in the definition", c)
+	}
+
+	reg := seq.Content[c.Index]
+	address := value(reg, "address")
+	name := value(reg, "name")
+	if address == nil || name == nil || name.Value != c.Name {
+		return nil, fmt.Errorf("%s: definition has changed", c)
+	}
+
+	if a, err := strconv.ParseInt(address.Value, 0, 64); err != nil || int(a) != c.Address {
+		return nil, fmt.Errorf("%s: definition has changed", c)
+	}
+
+	return reg, nil
+}
+
+func value(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping == nil || mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func add(lines []string, models *yaml.Node, model string) (edit, error) {
+	for _, m := range models.Content {
+		if m.Value == model {
+			return edit{}, fmt.Errorf("already listed")
+		}
+	}
+
+	quoted := strconv.Quote(model)
+
+	if len(models.Content) == 0 {
+		return edit{}, fmt.Errorf("empty models list applies to every model already")
+	}
+
+	last := models.Content[len(models.Content)-1]
+	lineIdx := last.Line - 1
+	line := lines[lineIdx]
+
+	// Block style, one "- model" per line
+	if models.Style&yaml.FlowStyle == 0 {
+		indent := strings.Repeat(" ", last.Column-3)
+		return edit{line: lineIdx + 1, insert: []string{indent + "- " + quoted + "\n"}}, nil
+	}
+
+	// Flow style on one line, slot it in before the ]
+	if last.Line == models.Line || !onlyItemOnLine(models, last) || closing(line) >= 0 {
+		end := closing(line)
+		if end < 0 {
+			return edit{}, fmt.Errorf("can't find the end of the list on line %d", last.Line)
+		}
+
+		before := strings.TrimRight(line[:end], " ")
+		sep := ", "
+		if strings.HasSuffix(before, ",") {
+			sep = " "
+		}
+		updated := before + sep + quoted + line[end:]
+		return edit{line: lineIdx, remove: 1, insert: []string{updated}}, nil
+	}
+
+	// Flow style spread over lines, one model to a line
+	indent := line[:last.Column-1]
+	// The last item may need a comma before the new one follows
+	end := last.Column - 1 + tokenLength(line[last.Column-1:])
+	if !strings.HasPrefix(strings.TrimLeft(line[end:], " "), ",") {
+		line = line[:end] + "," + line[end:]
+	}
+
+	return edit{line: lineIdx, remove: 1, insert: []string{line, indent + quoted + ",\n"}}, nil
+}
+
+func remove(lines []string, models *yaml.Node, model string) (edit, error) {
+	var item *yaml.Node
+	for _, m := range models.Content {
+		if m.Value == model {
+			item = m
+		}
+	}
+
+	if item == nil {
+		return edit{}, fmt.Errorf("not listed")
+	}
+
+	if len(models.Content) == 1 {
+		return edit{}, fmt.Errorf("removing the only model would apply the register to every model")
+	}
+
+	lineIdx := item.Line - 1
+	line := lines[lineIdx]
+
+	if onlyItemOnLine(models, item) && item.Line != models.Line && closing(line) < 0 {
+		return edit{line: lineIdx, remove: 1}, nil
+	}
+
+	// Cut the item and a separating comma out of the line
+	start := item.Column - 1
+	end := start + tokenLength(line[start:])
+
+	rest := line[end:]
+	trimmed := strings.TrimLeft(rest, " ")
+	if strings.HasPrefix(trimmed, ",") {
+		end += len(rest) - len(trimmed) + 1
+		for end < len(line) && line[end] == ' ' {
+			end++
+		}
+	} else {
+		// The last item, take the comma before it instead
+		before := strings.TrimRight(line[:start], " ")
+		if strings.HasSuffix(before, ",") {
+			start = len(before) - 1
+		}
+	}
+
+	return edit{line: lineIdx, remove: 1, insert: []string{line[:start] + line[end:]}}, nil
+}
+
+func onlyItemOnLine(models *yaml.Node, item *yaml.Node) bool {
+	for _, m := range models.Content {
+		if m != item && m.Line == item.Line {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenLength measures a quoted or plain scalar at the start of s
+func tokenLength(s string) int {
+	if s == "" {
+		return 0
+	}
+
+	if q := s[0]; q == '"' || q == '\'' {
+		for i := 1; i < len(s); i++ {
+			if s[i] == '\\' && q == '"' {
+				i++
+				continue
+			}
+			if s[i] == q {
+				return i + 1
+			}
+		}
+		return len(s)
+	}
+
+	end := strings.IndexAny(s+",]\n", ",]\n")
+	if i := strings.Index(s[:end], " #"); i >= 0 {
+		end = i
+	}
+	return len(strings.TrimRight(s[:end], " \r"))
+}
+
+// closing finds the ] ending a flow sequence on the line, ignoring comments
+func closing(line string) int {
+	if i := strings.Index(line, " #"); i >= 0 {
+		line = line[:i]
+	}
+	return bytes.LastIndexByte([]byte(line), ']')
+}
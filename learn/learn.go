@@ -0,0 +1,235 @@
+// Package learn works out which registers a model really supports by asking
+// a device, and edits the models lists of the register definition to match.
+package learn
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/transport"
+	"github.com/goburrow/modbus"
+)
+
+// Consecutive transport failures before giving up on a device
+const maxFailures = 10
+
+// Status is how a device responded to a register.
+type Status int
+
+const (
+	// Unknown registers couldn't be read for reasons other than the device refusing
+	Unknown Status = iota
+	// Supported registers answered
+	Supported
+	// Refused registers raised a Modbus exception
+	Refused
+)
+
+func (s Status) String() string {
+	switch s {
+	case Supported:
+		return "supported"
+	case Refused:
+		return "refused"
+	}
+	return "unknown"
+}
+
+// Result is what the device made of one register.
+type Result struct {
+	Holding bool
+	Address int
+	Name    string
+	Status  Status
+	// Whether the definition says the model has it
+	Listed bool
+	Err    error
+}
+
+// Bank names the register bank.
+func (r Result) Bank() string {
+	if r.Holding {
+		return "holding"
+	}
+	return "input"
+}
+
+// Probe reads every input and holding register in the definition from the
+// device, whatever models they're listed for, returning the device's model.
+func Probe(inv *sungrow.Inverter, client sungrow.Modbus) (string, []Result, error) {
+	def := inv.Clone()
+	err := def.ReadWithSkip(client, func(r sungrow.Register, funcCode int) bool {
+		return r.Name != "device_type_code"
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	model := def.Model()
+	if model == "" {
+		return "", nil, errors.New("device didn't say what model it is")
+	}
+
+	var results []Result
+	failures := 0
+	for _, g := range groups(inv) {
+		r := g.entries[0]
+
+		fn := client.ReadInputRegisters
+		if g.holding {
+			fn = client.ReadHoldingRegisters
+		}
+
+		res := Result{Holding: g.holding, Address: r.Address, Name: r.Name}
+		for _, e := range g.entries {
+			if e.Models.ContainsOrNull(model) {
+				res.Listed = true
+			}
+		}
+
+		_, err := fn(uint16(r.Address-1), uint16(r.Size()))
+
+		var mbErr *modbus.ModbusError
+		switch {
+		case err == nil:
+			res.Status, failures = Supported, 0
+		case errors.As(err, &mbErr):
+			res.Status, res.Err, failures = Refused, err, 0
+		case errors.Is(err, transport.ErrNotRecorded):
+			// Replaying a recording that never asked
+			res.Err = err
+		default:
+			res.Err = err
+			failures++
+			if failures >= maxFailures {
+				return model, results, fmt.Errorf("gave up at %s %d: %w", res.Bank(), r.Address, err)
+			}
+		}
+
+		results = append(results, res)
+	}
+
+	return model, results, nil
+}
+
+// group is the definitions of one register, there's one per model family
+// when they disagree on the details
+type group struct {
+	holding bool
+	entries []sungrow.Register
+	// Position of each entry in its bank
+	index []int
+}
+
+func groups(inv *sungrow.Inverter) []*group {
+	type key struct {
+		holding bool
+		address int
+		name    string
+	}
+
+	var ordered []*group
+	seen := map[key]*group{}
+
+	for _, bank := range []bool{false, true} {
+		regs := inv.Registers.Input
+		if bank {
+			regs = inv.Registers.Holding
+		}
+
+		for i, r := range regs {
+			k := key{bank, r.Address, r.Name}
+			g, isa := seen[k]
+			if !isa {
+				g = &group{holding: bank}
+				seen[k] = g
+				ordered = append(ordered, g)
+			}
+			g.entries = append(g.entries, r)
+			g.index = append(g.index, i)
+		}
+	}
+
+	return ordered
+}
+
+// Change adds or removes a model from the models list of a register.
+type Change struct {
+	Holding bool
+	// Position of the register in its bank
+	Index   int
+	Address int
+	Name    string
+	Model   string
+	Add     bool
+}
+
+func (c Change) String() string {
+	bank := "input"
+	if c.Holding {
+		bank = "holding"
+	}
+
+	if c.Add {
+		return fmt.Sprintf("add %s to %s %d %s", c.Model, bank, c.Address, c.Name)
+	}
+	return fmt.Sprintf("remove %s from %s %d %s", c.Model, bank, c.Address, c.Name)
+}
+
+// Propose works out the changes that make the definition agree with what
+// the model's device said, with notes on disagreements it can't fix.
+func Propose(inv *sungrow.Inverter, model string, results []Result) ([]Change, []string) {
+	status := map[string]Status{}
+	for _, r := range results {
+		status[fmt.Sprintf("%t/%d/%s", r.Holding, r.Address, r.Name)] = r.Status
+	}
+
+	var changes []Change
+	var notes []string
+
+	for _, g := range groups(inv) {
+		r := g.entries[0]
+		bank := "input"
+		if g.holding {
+			bank = "holding"
+		}
+
+		s := status[fmt.Sprintf("%t/%d/%s", g.holding, r.Address, r.Name)]
+
+		listed, everyone := false, false
+		for _, e := range g.entries {
+			if e.Models.ContainsOrNull(model) {
+				listed = true
+				everyone = everyone || len(e.Models) == 0
+			}
+		}
+
+		switch {
+		case s == Supported && !listed:
+			if len(g.entries) > 1 {
+				notes = append(notes, fmt.Sprintf("%s answers %s %d %s, which has %d definitions to choose from", model, bank, r.Address, r.Name, len(g.entries)))
+				continue
+			}
+			changes = append(changes, Change{Holding: g.holding, Index: g.index[0], Address: r.Address, Name: r.Name, Model: model, Add: true})
+
+		case s == Refused && listed:
+			if everyone {
+				notes = append(notes, fmt.Sprintf("%s refuses %s %d %s, which is defined for every model", model, bank, r.Address, r.Name))
+			}
+
+			for i, e := range g.entries {
+				if !e.Models.Contains(model) {
+					continue
+				}
+				if len(e.Models) == 1 {
+					notes = append(notes, fmt.Sprintf("%s refuses %s %d %s, it's the only model listed so it's been left", model, bank, r.Address, r.Name))
+					continue
+				}
+				changes = append(changes, Change{Holding: g.holding, Index: g.index[i], Address: r.Address, Name: r.Name, Model: model})
+			}
+		}
+	}
+
+	return changes, notes
+}
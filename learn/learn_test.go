@@ -0,0 +1,189 @@
+package learn_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/learn"
+	"github.com/goburrow/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+const registers = `# Test definition
+registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0x27: "SG30KTL"
+        0x26: "SG10KTL"
+    - address: 5003
+      name: "daily_output_energy" # everyone has this
+      scale: 0.1
+    - address: 5008
+      name: "internal_temperature"
+      models:
+        [
+          "SG10KTL",
+          "SG30KTL",
+        ]
+    - address: 5010
+      name: "mppt_2_voltage"
+      models: ["SG10KTL"] # only the big ones
+    - address: 5012
+      name: "mppt_3_voltage"
+      models: ["SG30KTL", "SG10KTL"]
+  holding:
+    - address: 5000
+      name: "system_clock_year"
+      models:
+        - "SG10KTL"
+`
+
+// fakeInverter answers the input registers it has and refuses the rest
+type fakeInverter struct {
+	input map[uint16]uint16
+}
+
+func (f *fakeInverter) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	var results []byte
+	for i := uint16(0); i < quantity; i++ {
+		v, isa := f.input[address+i]
+		if !isa {
+			return nil, &modbus.ModbusError{FunctionCode: 0x84, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}
+		}
+		results = append(results, byte(v>>8), byte(v))
+	}
+	return results, nil
+}
+
+func (f *fakeInverter) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	if address == 4999 {
+		return []byte{0x07, 0xE6}, nil
+	}
+	return nil, &modbus.ModbusError{FunctionCode: 0x83, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}
+}
+
+func TestProbeAndApply(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(registers)))
+
+	// An SG30KTL with a second MPPT but no third, and no temperature
+	fake := &fakeInverter{input: map[uint16]uint16{
+		4999: 0x27,
+		5002: 123,
+		5009: 3000,
+	}}
+
+	model, results, err := learn.Probe(&inv, fake)
+	requires.NoError(err)
+	requires.Equal("SG30KTL", model)
+	requires.Len(results, 6)
+
+	status := map[string]learn.Status{}
+	for _, r := range results {
+		status[r.Bank()+" "+r.Name] = r.Status
+	}
+	requires.Equal(learn.Supported, status["input daily_output_energy"])
+	requires.Equal(learn.Refused, status["input internal_temperature"])
+	requires.Equal(learn.Supported, status["input mppt_2_voltage"])
+	requires.Equal(learn.Refused, status["input mppt_3_voltage"])
+	requires.Equal(learn.Supported, status["holding system_clock_year"])
+
+	changes, notes := learn.Propose(&inv, model, results)
+	requires.Empty(notes)
+
+	var described []string
+	for _, c := range changes {
+		described = append(described, c.String())
+	}
+	requires.Equal([]string{
+		"remove SG30KTL from input 5008 internal_temperature",
+		"add SG30KTL to input 5010 mppt_2_voltage",
+		"remove SG30KTL from input 5012 mppt_3_voltage",
+		"add SG30KTL to holding 5000 system_clock_year",
+	}, described)
+
+	out, err := learn.Apply([]byte(registers), changes)
+	requires.NoError(err)
+
+	requires.Equal(`# Test definition
+registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0x27: "SG30KTL"
+        0x26: "SG10KTL"
+    - address: 5003
+      name: "daily_output_energy" # everyone has this
+      scale: 0.1
+    - address: 5008
+      name: "internal_temperature"
+      models:
+        [
+          "SG10KTL",
+        ]
+    - address: 5010
+      name: "mppt_2_voltage"
+      models: ["SG10KTL", "SG30KTL"] # only the big ones
+    - address: 5012
+      name: "mppt_3_voltage"
+      models: ["SG10KTL"]
+  holding:
+    - address: 5000
+      name: "system_clock_year"
+      models:
+        - "SG10KTL"
+        - "SG30KTL"
+`, string(out))
+
+	// Learning again from the updated definition has nothing more to say
+	var updated sungrow.Inverter
+	requires.NoError(updated.Define(bytes.NewReader(out)))
+
+	_, results, err = learn.Probe(&updated, fake)
+	requires.NoError(err)
+	changes, _ = learn.Propose(&updated, model, results)
+	requires.Empty(changes)
+}
+
+func TestApplyMultiLineAdd(t *testing.T) {
+	requires := require.New(t)
+
+	src := `registers:
+  input:
+    - address: 5008
+      name: "internal_temperature"
+      models:
+        [
+          "SG10KTL",
+          "SG30KTL" # no trailing comma
+        ]
+`
+
+	out, err := learn.Apply([]byte(src), []learn.Change{
+		{Index: 0, Address: 5008, Name: "internal_temperature", Model: "SG36KTL", Add: true},
+		{Index: 0, Address: 5008, Name: "internal_temperature", Model: "SG10KTL"},
+	})
+	requires.NoError(err)
+	requires.Equal(`registers:
+  input:
+    - address: 5008
+      name: "internal_temperature"
+      models:
+        [
+          "SG30KTL", # no trailing comma
+          "SG36KTL",
+        ]
+`, string(out))
+
+	_, err = learn.Apply([]byte(src), []learn.Change{
+		{Index: 0, Address: 5009, Name: "internal_temperature", Model: "SG36KTL", Add: true},
+	})
+	requires.Error(err)
+}
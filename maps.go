@@ -0,0 +1,396 @@
+package sungrow
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+
+	"github.com/goburrow/modbus"
+	"gopkg.in/yaml.v3"
+)
+
+// Families of devices, Sungrow documents the registers of each separately.
+const (
+	FamilyHybrid = "hybrid"
+	FamilyString = "string"
+)
+
+// Addresses of the protocol_no and protocol_ver input registers
+const (
+	protocolNoAddress  = 4950
+	protocolVerAddress = 4952
+)
+
+//go:embed maps
+var embedded embed.FS
+
+// Maps are the register maps built in to the library.
+var Maps = mustRegistry(embedded, "maps/maps.yml")
+
+// MapKey describes a device, or the devices a map is for where the zero
+// value of a field matches every device.
+type MapKey struct {
+	Family   string `yaml:"family,omitempty"`
+	Protocol uint32 `yaml:"protocol_no,omitempty"`
+	Version  uint32 `yaml:"protocol_ver,omitempty"`
+}
+
+func (k MapKey) String() string {
+	family := k.Family
+	if family == "" {
+		family = "any"
+	}
+	return fmt.Sprintf("%s family, protocol %#x version %#x", family, k.Protocol, k.Version)
+}
+
+// matches scores how closely the map key fits the device, -1 if it doesn't
+func (k MapKey) matches(device MapKey) int {
+	score := 0
+
+	switch {
+	case k.Family == "":
+	case k.Family == device.Family:
+		score++
+	default:
+		return -1
+	}
+
+	switch {
+	case k.Protocol == 0:
+	case k.Protocol == device.Protocol:
+		score += 2
+	default:
+		return -1
+	}
+
+	switch {
+	case k.Version == 0:
+	case k.Version == device.Version:
+		score += 4
+	default:
+		return -1
+	}
+
+	return score
+}
+
+type registryEntry struct {
+	MapKey `yaml:",inline"`
+	File   string `yaml:"file"`
+}
+
+// Registry finds the register map for a device.
+type Registry struct {
+	fsys    fs.FS
+	entries []registryEntry
+}
+
+// NewRegistry reads the index of the maps in fsys, the files it lists are
+// relative to it.
+func NewRegistry(fsys fs.FS, index string) (*Registry, error) {
+	b, err := fs.ReadFile(fsys, index)
+	if err != nil {
+		return nil, err
+	}
+
+	var idx struct {
+		Maps []registryEntry `yaml:"maps"`
+	}
+	if err := yaml.Unmarshal(b, &idx); err != nil {
+		return nil, fmt.Errorf("%s: %w", index, err)
+	}
+
+	r := &Registry{fsys: fsys}
+	for _, e := range idx.Maps {
+		if e.File == "" {
+			return nil, fmt.Errorf("%s: map for %s has no file", index, e.MapKey)
+		}
+		r.Register(e.MapKey, path.Join(path.Dir(index), e.File))
+	}
+
+	return r, nil
+}
+
+func mustRegistry(fsys fs.FS, index string) *Registry {
+	r, err := NewRegistry(fsys, index)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Register adds a map for the devices the key describes.
+func (r *Registry) Register(key MapKey, file string) {
+	r.entries = append(r.entries, registryEntry{MapKey: key, File: file})
+}
+
+// Lookup returns the file of the map that matches the device most closely,
+// the first registered wins a tie.
+func (r *Registry) Lookup(device MapKey) (string, error) {
+	best, score := "", -1
+	for _, e := range r.entries {
+		if s := e.matches(device); s > score {
+			best, score = e.File, s
+		}
+	}
+
+	if score < 0 {
+		return "", fmt.Errorf("no register map for %s", device)
+	}
+
+	return best, nil
+}
+
+// Define defines the inverter from the map for the device.
+func (r *Registry) Define(inv *Inverter, device MapKey) error {
+	name, err := r.Lookup(device)
+	if err != nil {
+		return err
+	}
+
+	b, err := fs.ReadFile(r.fsys, name)
+	if err != nil {
+		return err
+	}
+
+	return inv.Define(bytes.NewReader(b))
+}
+
+// DefineDefault defines the inverter from the built in map that suits every
+// device, with the override files layered on top. Empty names are skipped so
+// an unset flag can be passed straight through. The first read detects the
+// device and switches to the map that suits it best.
+func (i *Inverter) DefineDefault(overrides ...string) error {
+	var files []string
+	for _, name := range overrides {
+		if name != "" {
+			files = append(files, name)
+		}
+	}
+
+	if err := Maps.Define(i, MapKey{}); err != nil {
+		return err
+	}
+
+	if err := i.overlayFiles(files); err != nil {
+		return err
+	}
+
+	i.overrides, i.detect = files, true
+
+	return nil
+}
+
+// Detect reads what the device is and defines the inverter from the built
+// in map that suits it, with the overrides given to DefineDefault layered
+// on top again. There's nothing to read while one map suits every device.
+func (i *Inverter) Detect(client Modbus) error {
+	if len(Maps.entries) < 2 {
+		i.detect = false
+		return nil
+	}
+
+	key, err := i.mapKey(client)
+	if err != nil {
+		return err
+	}
+
+	var def Inverter
+	if err := Maps.Define(&def, key); err != nil {
+		return err
+	}
+
+	if err := def.overlayFiles(i.overrides); err != nil {
+		return err
+	}
+
+	i.Registers, i.Faults = def.Registers, def.Faults
+	i.detect = false
+
+	return nil
+}
+
+// mapKey reads the device type and protocol registers
+func (i *Inverter) mapKey(client Modbus) (MapKey, error) {
+	var key MapKey
+
+	var code *Register
+	for idx := range i.Registers.Input {
+		if i.Registers.Input[idx].Address == deviceTypeCodeAddress {
+			code = &i.Registers.Input[idx]
+			break
+		}
+	}
+	if code == nil {
+		return key, errors.New("definition has no device_type_code register")
+	}
+
+	r := *code
+	results, err := client.ReadInputRegisters(deviceTypeCodeAddress-1, 1)
+	if err != nil {
+		return key, err
+	}
+	if err := r.Decode(results); err != nil {
+		return key, err
+	}
+
+	_, hybrid := deviceModel(r.Value)
+	key.Family = FamilyString
+	if hybrid {
+		key.Family = FamilyHybrid
+	}
+
+	for _, p := range []struct {
+		address uint16
+		v       *uint32
+	}{{protocolNoAddress, &key.Protocol}, {protocolVerAddress, &key.Version}} {
+		results, err := client.ReadInputRegisters(p.address-1, 2)
+		if err != nil {
+			// Older devices don't say, any map for the family will do
+			var mbErr *modbus.ModbusError
+			if errors.As(err, &mbErr) {
+				continue
+			}
+			return key, err
+		}
+
+		if *p.v, err = readUint32(bytes.NewReader(results)); err != nil {
+			return key, err
+		}
+	}
+
+	return key, nil
+}
+
+// Overlay layers a register definition on top of the inverter's. Registers
+// with the same address, name and models replace those already defined,
+// the rest are added. Fault catalogues are merged the same way, codes with
+// the same code and models replace those already in the catalogue.
+func (i *Inverter) Overlay(r io.Reader) error {
+	var o Inverter
+	if err := yaml.NewDecoder(r).Decode(&o); err != nil && err != io.EOF {
+		return err
+	}
+
+	i.Registers.Input = overlay(i.Registers.Input, o.Registers.Input)
+	i.Registers.Holding = overlay(i.Registers.Holding, o.Registers.Holding)
+	i.Registers.Computed = overlay(i.Registers.Computed, o.Registers.Computed)
+
+	for name, c := range o.Faults {
+		if i.Faults == nil {
+			i.Faults = map[string]*FaultCatalogue{}
+		}
+		i.Faults[name] = overlayFaults(i.Faults[name], c)
+	}
+
+	if err := i.resolveFaults(); err != nil {
+		return err
+	}
+
+	return i.validateComputed()
+}
+
+// OverlayFromYaml layers a register definition file on top of the inverter's.
+func (i *Inverter) OverlayFromYaml(yamlFile string) error {
+	f, err := os.Open(yamlFile)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	return i.Overlay(f)
+}
+
+func (i *Inverter) overlayFiles(files []string) error {
+	for _, name := range files {
+		if err := i.OverlayFromYaml(name); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func overlay(regs, over []Register) []Register {
+	for _, o := range over {
+		replaced := false
+		for idx, r := range regs {
+			if r.Address == o.Address && r.Name == o.Name && sameModels(r.Models, o.Models) {
+				regs[idx] = o
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			regs = append(regs, o)
+		}
+	}
+
+	return regs
+}
+
+// overlayFaults merges the codes of over into a copy of c, the catalogue
+// keeps its severity and bitmask unless over sets them
+func overlayFaults(c, over *FaultCatalogue) *FaultCatalogue {
+	if c == nil {
+		return over
+	}
+
+	merged := *c
+	merged.Codes = append([]FaultDefinition(nil), c.Codes...)
+
+	if over.Bitmask {
+		merged.Bitmask = true
+	}
+	if over.Severity != "" {
+		merged.Severity = over.Severity
+	}
+
+	for _, o := range over.Codes {
+		replaced := false
+		for idx, d := range merged.Codes {
+			if d.Code == o.Code && sameModels(d.Models, o.Models) {
+				merged.Codes[idx] = o
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			merged.Codes = append(merged.Codes, o)
+		}
+	}
+
+	return &merged
+}
+
+func sameModels(a, b Models) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, m := range a {
+		if !b.Contains(m) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Family returns FamilyHybrid or FamilyString for the model reported by
+// device_type_code during the last read, empty before then.
+func (i *Inverter) Family() string {
+	model, hybrid := deviceModel(i.deviceTypeCode())
+	switch {
+	case model == "":
+		return ""
+	case hybrid:
+		return FamilyHybrid
+	}
+	return FamilyString
+}
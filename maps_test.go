@@ -0,0 +1,166 @@
+package sungrow_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/transport"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryLookup(t *testing.T) {
+	requires := require.New(t)
+
+	name, err := sungrow.Maps.Lookup(sungrow.MapKey{Family: sungrow.FamilyHybrid, Protocol: 0x1234})
+	requires.NoError(err)
+	requires.Equal("maps/sungrow.yml", name)
+
+	fsys := fstest.MapFS{
+		"maps/index.yml": {Data: []byte(`maps:
+  - file: any.yml
+  - file: hybrid.yml
+    family: hybrid
+  - file: hybrid-v2.yml
+    family: hybrid
+    protocol_no: 0x1234
+    protocol_ver: 2
+  - file: string.yml
+    family: string
+`)},
+	}
+
+	r, err := sungrow.NewRegistry(fsys, "maps/index.yml")
+	requires.NoError(err)
+
+	for key, want := range map[sungrow.MapKey]string{
+		{}:                             "maps/any.yml",
+		{Family: sungrow.FamilyString}: "maps/string.yml",
+		{Family: sungrow.FamilyHybrid}: "maps/hybrid.yml",
+		{Family: sungrow.FamilyHybrid, Protocol: 0x1234, Version: 1}: "maps/hybrid.yml",
+		{Family: sungrow.FamilyHybrid, Protocol: 0x1234, Version: 2}: "maps/hybrid-v2.yml",
+	} {
+		got, err := r.Lookup(key)
+		requires.NoError(err)
+		requires.Equal(want, got, key.String())
+	}
+
+	r, err = sungrow.NewRegistry(fstest.MapFS{"index.yml": {Data: []byte("maps:\n  - file: s.yml\n    family: string\n")}}, "index.yml")
+	requires.NoError(err)
+	_, err = r.Lookup(sungrow.MapKey{Family: sungrow.FamilyHybrid})
+	requires.Error(err)
+}
+
+func TestDefineDefault(t *testing.T) {
+	requires := require.New(t)
+
+	override := filepath.Join(t.TempDir(), "override.yml")
+	requires.NoError(os.WriteFile(override, []byte(`registers:
+  input:
+    # Report the daily yield in Wh
+    - address: 5003
+      name: "daily_power_yields"
+      unit: "Wh"
+      scale: 100
+    - address: 13999
+      name: "my_register"
+      models: ["SH10RT"]
+`), 0o644))
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.DefineDefault("", override))
+
+	client, err := transport.Dial("replay://" + filepath.Join("testdata", "recordings", "fake-sg5ktl-mt.jsonl"))
+	requires.NoError(err)
+	defer client.Close()
+
+	requires.NoError(inv.Read(client))
+	requires.Equal("SG5KTL-MT", inv.Model())
+	requires.Equal(sungrow.FamilyString, inv.Family())
+
+	daily := inv.Registers.Find("daily_power_yields", inv.Model())
+	requires.NotNil(daily)
+	requires.Equal("Wh", daily.GetUnit())
+
+	var plain sungrow.Inverter
+	requires.NoError(plain.DefineFromYaml(filepath.Join("maps", "sungrow.yml")))
+	requires.Equal(len(plain.Registers.Input)+1, len(inv.Registers.Input))
+
+	// One map suits every device, there's nothing to ask the device
+	var single sungrow.Inverter
+	requires.NoError(single.DefineDefault())
+	requires.NoError(single.Detect(nil))
+}
+
+func TestOverlay(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(`registers:
+  input:
+    - address: 5003
+      name: "daily_power_yields"
+      scale: 0.1
+      models: ["A"]
+    - address: 5003
+      name: "daily_power_yields"
+      scale: 0.01
+      models: ["B"]
+`)))
+
+	requires.NoError(inv.Overlay(strings.NewReader(`registers:
+  input:
+    - address: 5003
+      name: "daily_power_yields"
+      scale: 1
+      models: ["B"]
+  computed:
+    - name: "doubled"
+      formula: "daily_power_yields * 2"
+`)))
+
+	requires.Len(inv.Registers.Input, 2)
+	requires.Equal(0.1, inv.Registers.Find("daily_power_yields", "A").Scale)
+	requires.Equal(1.0, inv.Registers.Find("daily_power_yields", "B").Scale)
+	requires.Len(inv.Registers.Computed, 1)
+
+	requires.Error(inv.Overlay(strings.NewReader("registers:\n  computed:\n    - name: \"broken\"\n")))
+}
+
+func TestOverlayFaults(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(`registers:
+  input:
+    - address: 5045
+      name: "fault_code"
+      faults: "inverter"
+faults:
+  inverter:
+    codes:
+      - code: 2
+        description: "Grid overvoltage"
+      - code: 4
+        description: "Grid undervoltage"
+`)))
+
+	requires.NoError(inv.Overlay(strings.NewReader(`faults:
+  inverter:
+    codes:
+      - code: 4
+        description: "Grid voltage too low"
+        severity: "alarm"
+      - code: 8
+        description: "Grid overfrequency"
+`)))
+
+	c := inv.Faults["inverter"]
+	requires.Len(c.Codes, 3)
+	requires.Equal("Grid overvoltage", c.Lookup(2, "").Description)
+	requires.Equal(sungrow.Fault{Code: 4, Description: "Grid voltage too low", Severity: sungrow.SeverityAlarm}, c.Lookup(4, ""))
+	requires.Equal("Grid overfrequency", c.Lookup(8, "").Description)
+}
@@ -1,5 +1,8 @@
 package sungrow
 
+// Address of the device_type_code input register
+const deviceTypeCodeAddress = 5000
+
 type Models []string
 
 func (m Models) Contains(v string) bool {
@@ -14,3 +17,36 @@ func (m Models) Contains(v string) bool {
 func (m Models) ContainsOrNull(v string) bool {
 	return len(m) == 0 || m.Contains(v)
 }
+
+// Model returns the model reported by device_type_code during the last read.
+func (i *Inverter) Model() string {
+	model, _ := deviceModel(i.deviceTypeCode())
+	return model
+}
+
+// Hybrid reports whether the model reported by device_type_code is a hybrid.
+func (i *Inverter) Hybrid() bool {
+	_, hybrid := deviceModel(i.deviceTypeCode())
+	return hybrid
+}
+
+func (i *Inverter) deviceTypeCode() interface{} {
+	for _, r := range i.Registers.Input {
+		if r.Address == deviceTypeCodeAddress && r.Supported && r.Err == nil {
+			return r.Value
+		}
+	}
+	return nil
+}
+
+// deviceModel extracts the model name from a decoded device_type_code value.
+func deviceModel(v interface{}) (model string, hybrid bool) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		model, _ = x["name"].(string)
+		hybrid, _ = x["hybrid"].(bool)
+	case string:
+		model = x
+	}
+	return
+}
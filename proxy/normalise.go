@@ -0,0 +1,81 @@
+package proxy
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/freman/sungrow/transport"
+	"github.com/goburrow/modbus"
+)
+
+// Normalise repairs a response so that it is what a standards compliant
+// client expects for the request, it returns the quirks that were repaired.
+func Normalise(request, response *modbus.ProtocolDataUnit) (*modbus.ProtocolDataUnit, []transport.Quirk) {
+	var repaired []transport.Quirk
+
+	if isException(response) {
+		fixed := &modbus.ProtocolDataUnit{FunctionCode: response.FunctionCode, Data: response.Data}
+
+		if fixed.FunctionCode != request.FunctionCode|0x80 {
+			fixed.FunctionCode = request.FunctionCode | 0x80
+			repaired = append(repaired, transport.QuirkExceptionFunction)
+		}
+
+		if len(fixed.Data) != 1 {
+			if len(fixed.Data) == 0 {
+				fixed.Data = []byte{modbus.ExceptionCodeServerDeviceFailure}
+			} else {
+				fixed.Data = fixed.Data[:1]
+			}
+			repaired = append(repaired, transport.QuirkEmptyException)
+		}
+
+		return fixed, repaired
+	}
+
+	if response.FunctionCode != request.FunctionCode {
+		return Exception(request.FunctionCode, modbus.ExceptionCodeServerDeviceFailure), []transport.Quirk{transport.QuirkExceptionFunction}
+	}
+
+	switch request.FunctionCode {
+	case modbus.FuncCodeReadInputRegisters, modbus.FuncCodeReadHoldingRegisters:
+		if len(request.Data) < 4 {
+			break
+		}
+
+		want := int(binary.BigEndian.Uint16(request.Data[2:])) * 2
+		if len(response.Data) < 1 {
+			return Exception(request.FunctionCode, modbus.ExceptionCodeServerDeviceFailure), []transport.Quirk{transport.QuirkByteCount}
+		}
+
+		data := response.Data[1:]
+		if int(response.Data[0]) == want && len(data) == want {
+			break
+		}
+
+		if len(data) < want {
+			return Exception(request.FunctionCode, modbus.ExceptionCodeServerDeviceFailure), []transport.Quirk{transport.QuirkByteCount}
+		}
+
+		response = &modbus.ProtocolDataUnit{
+			FunctionCode: response.FunctionCode,
+			Data:         append([]byte{byte(want)}, data[:want]...),
+		}
+		repaired = append(repaired, transport.QuirkByteCount)
+	}
+
+	return response, repaired
+}
+
+// exceptionFor converts an upstream error into the exception code a gateway should respond with.
+func exceptionFor(err error) byte {
+	var mbErr *modbus.ModbusError
+	switch {
+	case errors.As(err, &mbErr):
+		return mbErr.ExceptionCode
+	case errors.Is(err, transport.ErrNotSupported):
+		return modbus.ExceptionCodeIllegalFunction
+	}
+
+	return modbus.ExceptionCodeGatewayTargetDeviceFailedToRespond
+}
@@ -0,0 +1,112 @@
+package proxy_test
+
+import (
+	"testing"
+
+	"github.com/freman/sungrow/proxy"
+	"github.com/freman/sungrow/transport"
+	"github.com/goburrow/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalise(t *testing.T) {
+	readHolding := &modbus.ProtocolDataUnit{FunctionCode: modbus.FuncCodeReadHoldingRegisters, Data: []byte{0x32, 0xC9, 0, 2}}
+
+	tests := map[string]struct {
+		response *modbus.ProtocolDataUnit
+		expected *modbus.ProtocolDataUnit
+		repaired []transport.Quirk
+	}{
+		"good": {
+			response: &modbus.ProtocolDataUnit{FunctionCode: 3, Data: []byte{4, 0, 1, 0, 2}},
+			expected: &modbus.ProtocolDataUnit{FunctionCode: 3, Data: []byte{4, 0, 1, 0, 2}},
+		},
+		"good exception": {
+			response: &modbus.ProtocolDataUnit{FunctionCode: 0x83, Data: []byte{2}},
+			expected: &modbus.ProtocolDataUnit{FunctionCode: 0x83, Data: []byte{2}},
+		},
+		"exception function": {
+			response: &modbus.ProtocolDataUnit{FunctionCode: 0x84, Data: []byte{2}},
+			expected: &modbus.ProtocolDataUnit{FunctionCode: 0x83, Data: []byte{2}},
+			repaired: []transport.Quirk{transport.QuirkExceptionFunction},
+		},
+		"empty exception": {
+			response: &modbus.ProtocolDataUnit{FunctionCode: 0x83},
+			expected: &modbus.ProtocolDataUnit{FunctionCode: 0x83, Data: []byte{modbus.ExceptionCodeServerDeviceFailure}},
+			repaired: []transport.Quirk{transport.QuirkEmptyException},
+		},
+		"wrong byte count": {
+			response: &modbus.ProtocolDataUnit{FunctionCode: 3, Data: []byte{2, 0, 1, 0, 2}},
+			expected: &modbus.ProtocolDataUnit{FunctionCode: 3, Data: []byte{4, 0, 1, 0, 2}},
+			repaired: []transport.Quirk{transport.QuirkByteCount},
+		},
+		"too much data": {
+			response: &modbus.ProtocolDataUnit{FunctionCode: 3, Data: []byte{6, 0, 1, 0, 2, 0, 3}},
+			expected: &modbus.ProtocolDataUnit{FunctionCode: 3, Data: []byte{4, 0, 1, 0, 2}},
+			repaired: []transport.Quirk{transport.QuirkByteCount},
+		},
+		"too little data": {
+			response: &modbus.ProtocolDataUnit{FunctionCode: 3, Data: []byte{4, 0, 1}},
+			expected: &modbus.ProtocolDataUnit{FunctionCode: 0x83, Data: []byte{modbus.ExceptionCodeServerDeviceFailure}},
+			repaired: []transport.Quirk{transport.QuirkByteCount},
+		},
+		"wrong function": {
+			response: &modbus.ProtocolDataUnit{FunctionCode: 4, Data: []byte{4, 0, 1, 0, 2}},
+			expected: &modbus.ProtocolDataUnit{FunctionCode: 0x83, Data: []byte{modbus.ExceptionCodeServerDeviceFailure}},
+			repaired: []transport.Quirk{transport.QuirkExceptionFunction},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			requires := require.New(t)
+			response, repaired := proxy.Normalise(readHolding, test.response)
+			requires.Equal(test.expected, response)
+			requires.Equal(test.repaired, repaired)
+		})
+	}
+}
+
+// readOnlyUpstream behaves like the http transport, refusing anything but reads
+// and reporting exceptions against the wrong function.
+type readOnlyUpstream struct {
+	fakeUpstream
+}
+
+func (f *readOnlyUpstream) Encode(pdu *modbus.ProtocolDataUnit) ([]byte, error) {
+	if pdu.FunctionCode != modbus.FuncCodeReadInputRegisters && pdu.FunctionCode != modbus.FuncCodeReadHoldingRegisters {
+		return nil, transport.ErrNotSupported
+	}
+	return f.fakeUpstream.Encode(pdu)
+}
+
+func (f *readOnlyUpstream) Decode(adu []byte) (*modbus.ProtocolDataUnit, error) {
+	pdu, err := f.fakeUpstream.Decode(adu)
+	if pdu.FunctionCode&0x80 != 0 {
+		pdu.FunctionCode = 0x84
+	}
+	return pdu, err
+}
+
+func TestGateway(t *testing.T) {
+	requires := require.New(t)
+
+	address := startServer(t, &readOnlyUpstream{}, func(s *proxy.Server) {
+		s.Normalise = true
+	})
+	client := newClient(t, address)
+
+	results, err := client.ReadHoldingRegisters(4999, 1)
+	requires.NoError(err)
+	requires.Equal([]byte{0x13, 0x87}, results)
+
+	var mbErr *modbus.ModbusError
+	_, err = client.ReadHoldingRegisters(0xFFFF, 1)
+	requires.ErrorAs(err, &mbErr)
+	requires.EqualValues(modbus.FuncCodeReadHoldingRegisters|0x80, mbErr.FunctionCode)
+	requires.EqualValues(modbus.ExceptionCodeIllegalDataAddress, mbErr.ExceptionCode)
+
+	_, err = client.WriteSingleRegister(13049, 2)
+	requires.ErrorAs(err, &mbErr)
+	requires.EqualValues(modbus.ExceptionCodeIllegalFunction, mbErr.ExceptionCode)
+}
@@ -0,0 +1,378 @@
+// Package proxy serves Modbus TCP to many clients over a single upstream connection.
+package proxy
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/freman/sungrow/transport"
+	"github.com/goburrow/modbus"
+)
+
+const (
+	tcpHeaderSize = 7
+	tcpMaxLength  = 260
+)
+
+// Server multiplexes downstream Modbus TCP clients onto one upstream handler,
+// requests are serialised, identical concurrent reads are coalesced and reads
+// can optionally be served from a short lived cache.
+type Server struct {
+	// Upstream handler, eg transport.NewBorkedTCPClient
+	Upstream modbus.ClientHandler
+	// How long to serve reads from the cache, zero disables caching
+	CacheTTL time.Duration
+	// Which reads are cached, all reads are cached if nil
+	Cacheable func(funcCode byte, address, quantity uint16) bool
+	// Repair responses so they are standards compliant, see transport.Quirks
+	Normalise bool
+	// Unit ID of the upstream device, requests addressed to other units are
+	// refused with a gateway path unavailable exception. Zero sends each
+	// request on to the unit it's addressed to when the upstream can change
+	// unit as the transport handlers can, see UnitSetter. Other upstreams
+	// answer for every unit.
+	UnitID byte
+	// Idle timeout for downstream connections
+	IdleTimeout time.Duration
+	// Transmission logger
+	Logger *log.Logger
+
+	upstream sync.Mutex
+
+	mu       sync.Mutex
+	inflight map[string]*call
+	cache    map[string]cached
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+
+	// Bumped by every write, reads sent before it aren't cached
+	generation uint64
+	pruned     time.Time
+}
+
+// UnitSetter is an upstream that can address any unit.
+type UnitSetter interface {
+	// SetSlaveID changes the unit ID of the requests encoded from now on
+	SetSlaveID(id byte)
+}
+
+type call struct {
+	done     chan struct{}
+	response *modbus.ProtocolDataUnit
+}
+
+type cached struct {
+	response *modbus.ProtocolDataUnit
+	expires  time.Time
+}
+
+// NewServer allocates a new Server.
+func NewServer(upstream modbus.ClientHandler) *Server {
+	return &Server{
+		Upstream:    upstream,
+		IdleTimeout: 5 * time.Minute,
+	}
+}
+
+// ListenAndServe listens on the TCP address and serves clients.
+func (s *Server) ListenAndServe(address string) error {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(l)
+}
+
+// Serve accepts connections on the listener until it is closed.
+func (s *Server) Serve(l net.Listener) error {
+	s.mu.Lock()
+	s.listener = l
+	if s.conns == nil {
+		s.conns = map[net.Conn]struct{}{}
+	}
+	s.mu.Unlock()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		s.mu.Lock()
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+
+		go s.serveConn(conn)
+	}
+}
+
+// Close stops accepting connections and closes the connected clients.
+func (s *Server) Close() (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener != nil {
+		err = s.listener.Close()
+	}
+
+	for conn := range s.conns {
+		conn.Close()
+		delete(s.conns, conn)
+	}
+
+	return
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer func() {
+		conn.Close()
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+	}()
+
+	s.logf("proxy: client %v connected", conn.RemoteAddr())
+
+	for {
+		if s.IdleTimeout > 0 {
+			conn.SetReadDeadline(time.Now().Add(s.IdleTimeout))
+		}
+
+		header, request, err := ReadFrame(conn)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				s.logf("proxy: client %v: %v", conn.RemoteAddr(), err)
+			}
+			return
+		}
+
+		response := s.Handle(header[6], request)
+
+		if _, err := conn.Write(EncodeFrame(header, response)); err != nil {
+			s.logf("proxy: client %v: %v", conn.RemoteAddr(), err)
+			return
+		}
+	}
+}
+
+// Handle processes a single request for the unit, it never fails, errors
+// talking to the upstream are returned as Modbus gateway exceptions.
+func (s *Server) Handle(unit byte, request *modbus.ProtocolDataUnit) *modbus.ProtocolDataUnit {
+	if !s.routes(unit) {
+		return Exception(request.FunctionCode, modbus.ExceptionCodeGatewayPathUnavailable)
+	}
+
+	if !isRead(request.FunctionCode) {
+		response := s.send(unit, request)
+		// Anything written may have changed what is read
+		s.invalidate()
+		return response
+	}
+
+	key := string(append([]byte{unit, request.FunctionCode}, request.Data...))
+
+	s.mu.Lock()
+	if c, isa := s.cache[key]; isa && time.Now().Before(c.expires) {
+		s.mu.Unlock()
+		return c.response
+	}
+
+	if c, isa := s.inflight[key]; isa {
+		s.mu.Unlock()
+		<-c.done
+		return c.response
+	}
+
+	c := &call{done: make(chan struct{})}
+	if s.inflight == nil {
+		s.inflight = map[string]*call{}
+	}
+	s.inflight[key] = c
+	generation := s.generation
+	s.mu.Unlock()
+
+	c.response = s.send(unit, request)
+
+	s.mu.Lock()
+	if s.inflight[key] == c {
+		delete(s.inflight, key)
+	}
+	// A write since the read was sent may have made the response stale
+	if generation == s.generation && s.cacheable(request) && !isException(c.response) {
+		s.store(key, c.response)
+	}
+	s.mu.Unlock()
+
+	close(c.done)
+
+	return c.response
+}
+
+// store caches the response, dropping expired responses at most once per
+// CacheTTL so the cache doesn't grow with every read ever made. s.mu must
+// be held.
+func (s *Server) store(key string, response *modbus.ProtocolDataUnit) {
+	now := time.Now()
+
+	if s.cache == nil {
+		s.cache = map[string]cached{}
+	}
+
+	if now.Sub(s.pruned) > s.CacheTTL {
+		for k, c := range s.cache {
+			if !now.Before(c.expires) {
+				delete(s.cache, k)
+			}
+		}
+		s.pruned = now
+	}
+
+	s.cache[key] = cached{response: response, expires: now.Add(s.CacheTTL)}
+}
+
+// routes reports whether requests for the unit can reach it
+func (s *Server) routes(unit byte) bool {
+	return s.UnitID == 0 || unit == s.UnitID
+}
+
+func (s *Server) send(unit byte, request *modbus.ProtocolDataUnit) *modbus.ProtocolDataUnit {
+	s.upstream.Lock()
+	defer s.upstream.Unlock()
+
+	if setter, isa := s.Upstream.(UnitSetter); isa && s.UnitID == 0 {
+		setter.SetSlaveID(unit)
+	}
+
+	response, err := s.roundTrip(request)
+	if err != nil {
+		s.logf("proxy: upstream: %v", err)
+		return Exception(request.FunctionCode, exceptionFor(err))
+	}
+
+	if s.Normalise {
+		var repaired []transport.Quirk
+		response, repaired = Normalise(request, response)
+		for _, q := range repaired {
+			s.logf("proxy: repaired %s in response to function %d", q.Name, request.FunctionCode)
+		}
+	}
+
+	return response
+}
+
+func (s *Server) roundTrip(request *modbus.ProtocolDataUnit) (*modbus.ProtocolDataUnit, error) {
+	aduRequest, err := s.Upstream.Encode(request)
+	if err != nil {
+		return nil, err
+	}
+
+	aduResponse, err := s.Upstream.Send(aduRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.Upstream.Verify(aduRequest, aduResponse); err != nil {
+		return nil, err
+	}
+
+	return s.Upstream.Decode(aduResponse)
+}
+
+func (s *Server) cacheable(request *modbus.ProtocolDataUnit) bool {
+	if s.CacheTTL <= 0 || len(request.Data) < 4 {
+		return false
+	}
+
+	if s.Cacheable == nil {
+		return true
+	}
+
+	return s.Cacheable(request.FunctionCode, binary.BigEndian.Uint16(request.Data), binary.BigEndian.Uint16(request.Data[2:]))
+}
+
+// invalidate forgets cached responses, and reads in flight so later reads
+// don't wait on a response that may be stale
+func (s *Server) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.generation++
+	s.cache = nil
+	s.inflight = nil
+}
+
+func (s *Server) logf(format string, v ...interface{}) {
+	if s.Logger != nil {
+		s.Logger.Printf(format, v...)
+	}
+}
+
+// ReadFrame reads a Modbus TCP frame, returning the MBAP header and the PDU.
+func ReadFrame(r io.Reader) (header []byte, pdu *modbus.ProtocolDataUnit, err error) {
+	var data [tcpMaxLength]byte
+	if _, err = io.ReadFull(r, data[:tcpHeaderSize]); err != nil {
+		return
+	}
+
+	length := int(binary.BigEndian.Uint16(data[4:]))
+	if length < 2 || length > tcpMaxLength-tcpHeaderSize+1 {
+		err = fmt.Errorf("modbus: invalid length in request header '%v'", length)
+		return
+	}
+
+	if _, err = io.ReadFull(r, data[tcpHeaderSize:tcpHeaderSize+length-1]); err != nil {
+		return
+	}
+
+	header = append([]byte(nil), data[:tcpHeaderSize]...)
+	pdu = &modbus.ProtocolDataUnit{
+		FunctionCode: data[tcpHeaderSize],
+		Data:         append([]byte(nil), data[tcpHeaderSize+1:tcpHeaderSize+length-1]...),
+	}
+
+	return
+}
+
+// EncodeFrame builds a Modbus TCP frame answering the request header.
+func EncodeFrame(header []byte, pdu *modbus.ProtocolDataUnit) []byte {
+	adu := make([]byte, tcpHeaderSize+1+len(pdu.Data))
+	copy(adu, header[:tcpHeaderSize])
+	binary.BigEndian.PutUint16(adu[4:], uint16(2+len(pdu.Data)))
+	adu[tcpHeaderSize] = pdu.FunctionCode
+	copy(adu[tcpHeaderSize+1:], pdu.Data)
+	return adu
+}
+
+// Exception builds an exception response for the function.
+func Exception(funcCode, exceptionCode byte) *modbus.ProtocolDataUnit {
+	return &modbus.ProtocolDataUnit{
+		FunctionCode: funcCode | 0x80,
+		Data:         []byte{exceptionCode},
+	}
+}
+
+func isException(pdu *modbus.ProtocolDataUnit) bool {
+	return pdu.FunctionCode&0x80 != 0
+}
+
+func isRead(funcCode byte) bool {
+	switch funcCode {
+	case modbus.FuncCodeReadCoils,
+		modbus.FuncCodeReadDiscreteInputs,
+		modbus.FuncCodeReadInputRegisters,
+		modbus.FuncCodeReadHoldingRegisters:
+		return true
+	}
+
+	return false
+}
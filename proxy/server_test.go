@@ -0,0 +1,251 @@
+package proxy_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow/proxy"
+	"github.com/goburrow/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUpstream answers every read with the register address as the value,
+// plus 0x100 for each unit past zero.
+type fakeUpstream struct {
+	calls int32
+	delay time.Duration
+	fail  bool
+	unit  byte
+}
+
+func (f *fakeUpstream) SetSlaveID(id byte) {
+	f.unit = id
+}
+
+func (f *fakeUpstream) Encode(pdu *modbus.ProtocolDataUnit) ([]byte, error) {
+	return append([]byte{pdu.FunctionCode}, pdu.Data...), nil
+}
+
+func (f *fakeUpstream) Verify(aduRequest, aduResponse []byte) error {
+	return nil
+}
+
+func (f *fakeUpstream) Decode(adu []byte) (*modbus.ProtocolDataUnit, error) {
+	return &modbus.ProtocolDataUnit{FunctionCode: adu[0], Data: adu[1:]}, nil
+}
+
+func (f *fakeUpstream) Send(aduRequest []byte) ([]byte, error) {
+	atomic.AddInt32(&f.calls, 1)
+	time.Sleep(f.delay)
+
+	if f.fail {
+		return nil, errors.New("no route to inverter")
+	}
+
+	address := binary.BigEndian.Uint16(aduRequest[1:])
+	quantity := binary.BigEndian.Uint16(aduRequest[3:])
+
+	switch aduRequest[0] {
+	case modbus.FuncCodeReadInputRegisters, modbus.FuncCodeReadHoldingRegisters:
+		if address == 0xFFFF {
+			return []byte{aduRequest[0] | 0x80, modbus.ExceptionCodeIllegalDataAddress}, nil
+		}
+
+		resp := []byte{aduRequest[0], byte(quantity * 2)}
+		for i := uint16(0); i < quantity; i++ {
+			v := address + i + uint16(f.unit)<<8
+			resp = append(resp, byte(v>>8), byte(v))
+		}
+		return resp, nil
+	}
+
+	return aduRequest, nil
+}
+
+func startServer(t *testing.T, upstream modbus.ClientHandler, configure func(*proxy.Server)) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := proxy.NewServer(upstream)
+	if configure != nil {
+		configure(server)
+	}
+
+	go server.Serve(l)
+	t.Cleanup(func() { server.Close() })
+
+	return l.Addr().String()
+}
+
+func newClient(t *testing.T, address string) modbus.Client {
+	return newUnitClient(t, address, 0)
+}
+
+func newUnitClient(t *testing.T, address string, unit byte) modbus.Client {
+	handler := modbus.NewTCPClientHandler(address)
+	handler.SlaveId = unit
+	t.Cleanup(func() { handler.Close() })
+	return modbus.NewClient(handler)
+}
+
+func TestProxy(t *testing.T) {
+	requires := require.New(t)
+
+	upstream := &fakeUpstream{}
+	address := startServer(t, upstream, nil)
+	client := newClient(t, address)
+
+	results, err := client.ReadInputRegisters(4999, 2)
+	requires.NoError(err)
+	requires.Equal([]byte{0x13, 0x87, 0x13, 0x88}, results)
+
+	_, err = client.ReadHoldingRegisters(0xFFFF, 1)
+	var mbErr *modbus.ModbusError
+	requires.ErrorAs(err, &mbErr)
+	requires.EqualValues(modbus.ExceptionCodeIllegalDataAddress, mbErr.ExceptionCode)
+
+	results, err = client.WriteSingleRegister(13049, 2)
+	requires.NoError(err)
+	requires.Equal([]byte{0, 2}, results)
+
+	upstream.fail = true
+	_, err = client.ReadInputRegisters(4999, 2)
+	requires.ErrorAs(err, &mbErr)
+	requires.EqualValues(modbus.ExceptionCodeGatewayTargetDeviceFailedToRespond, mbErr.ExceptionCode)
+}
+
+func TestProxyCoalesce(t *testing.T) {
+	requires := require.New(t)
+
+	upstream := &fakeUpstream{delay: 50 * time.Millisecond}
+	address := startServer(t, upstream, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		client := newClient(t, address)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results, err := client.ReadInputRegisters(4999, 1)
+			requires.NoError(err)
+			requires.Equal([]byte{0x13, 0x87}, results)
+		}()
+	}
+	wg.Wait()
+
+	requires.Less(atomic.LoadInt32(&upstream.calls), int32(5))
+}
+
+func TestProxyCache(t *testing.T) {
+	requires := require.New(t)
+
+	upstream := &fakeUpstream{}
+	address := startServer(t, upstream, func(s *proxy.Server) {
+		s.CacheTTL = time.Minute
+		s.Cacheable = func(funcCode byte, address, quantity uint16) bool {
+			return funcCode == modbus.FuncCodeReadInputRegisters
+		}
+	})
+	client := newClient(t, address)
+
+	for i := 0; i < 3; i++ {
+		_, err := client.ReadInputRegisters(4999, 1)
+		requires.NoError(err)
+		_, err = client.ReadHoldingRegisters(4999, 1)
+		requires.NoError(err)
+	}
+	requires.EqualValues(4, atomic.LoadInt32(&upstream.calls))
+
+	// Writes invalidate the cache
+	_, err := client.WriteSingleRegister(13049, 2)
+	requires.NoError(err)
+	_, err = client.ReadInputRegisters(4999, 1)
+	requires.NoError(err)
+	requires.EqualValues(6, atomic.LoadInt32(&upstream.calls))
+}
+
+func TestProxyUnitID(t *testing.T) {
+	requires := require.New(t)
+
+	upstream := &fakeUpstream{}
+	address := startServer(t, upstream, func(s *proxy.Server) {
+		s.UnitID = 1
+	})
+
+	_, err := newUnitClient(t, address, 1).ReadInputRegisters(4999, 1)
+	requires.NoError(err)
+
+	_, err = newUnitClient(t, address, 2).ReadInputRegisters(4999, 1)
+	var mbErr *modbus.ModbusError
+	requires.ErrorAs(err, &mbErr)
+	requires.EqualValues(modbus.ExceptionCodeGatewayPathUnavailable, mbErr.ExceptionCode)
+	requires.EqualValues(1, atomic.LoadInt32(&upstream.calls))
+}
+
+func TestProxyUnits(t *testing.T) {
+	requires := require.New(t)
+
+	address := startServer(t, &fakeUpstream{}, nil)
+
+	// Each unit is asked for its own registers
+	results, err := newUnitClient(t, address, 1).ReadInputRegisters(4999, 1)
+	requires.NoError(err)
+	requires.Equal([]byte{0x14, 0x87}, results)
+
+	results, err = newUnitClient(t, address, 2).ReadInputRegisters(4999, 1)
+	requires.NoError(err)
+	requires.Equal([]byte{0x15, 0x87}, results)
+
+	// An upstream stuck on one unit answers for the rest
+	stuck := startServer(t, &struct{ modbus.ClientHandler }{&fakeUpstream{}}, nil)
+	results, err = newUnitClient(t, stuck, 2).ReadInputRegisters(4999, 1)
+	requires.NoError(err)
+	requires.Equal([]byte{0x13, 0x87}, results)
+}
+
+func TestProxyCacheUnits(t *testing.T) {
+	requires := require.New(t)
+
+	upstream := &fakeUpstream{}
+	server := proxy.NewServer(upstream)
+	server.CacheTTL = time.Minute
+
+	request := &modbus.ProtocolDataUnit{FunctionCode: modbus.FuncCodeReadInputRegisters, Data: []byte{0x13, 0x87, 0, 1}}
+	server.Handle(1, request)
+	server.Handle(1, request)
+	requires.EqualValues(1, atomic.LoadInt32(&upstream.calls))
+
+	// Other units aren't answered from the first's cache
+	server.Handle(2, request)
+	requires.EqualValues(2, atomic.LoadInt32(&upstream.calls))
+}
+
+func TestProxyWriteDuringRead(t *testing.T) {
+	requires := require.New(t)
+
+	upstream := &fakeUpstream{delay: 50 * time.Millisecond}
+	server := proxy.NewServer(upstream)
+	server.CacheTTL = time.Minute
+
+	read := &modbus.ProtocolDataUnit{FunctionCode: modbus.FuncCodeReadHoldingRegisters, Data: []byte{0x32, 0xF8, 0, 1}}
+	write := &modbus.ProtocolDataUnit{FunctionCode: modbus.FuncCodeWriteSingleRegister, Data: []byte{0x32, 0xF8, 0, 2}}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		server.Handle(0, read)
+	}()
+
+	// The write waits for the read, reads after it go upstream again
+	time.Sleep(10 * time.Millisecond)
+	server.Handle(0, write)
+	<-done
+
+	server.Handle(0, read)
+	requires.EqualValues(3, atomic.LoadInt32(&upstream.calls))
+}
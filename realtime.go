@@ -0,0 +1,124 @@
+package sungrow
+
+import (
+	"strings"
+
+	"github.com/freman/sungrow/transport"
+)
+
+// RealtimeSource provides values the way the WiNet-S web interface sees them.
+type RealtimeSource interface {
+	Model() string
+	Realtime() ([]transport.RealtimeValue, error)
+}
+
+// RealtimeNames maps the names reported by the WiNet-S websocket onto register names.
+var RealtimeNames = map[string]string{
+	"I18N_COMMON_DAILY_YIELD":                     "daily_power_yields",
+	"I18N_COMMON_TOTAL_YIELD":                     "total_power_yields",
+	"I18N_COMMON_AIR_TEM_INSIDE_MACHINE":          "internal_temperature",
+	"I18N_COMMON_TOTAL_DCPOWER":                   "total_dc_power",
+	"I18N_COMMON_UAB_VOLTAGE":                     "voltage_a_phase",
+	"I18N_COMMON_UBC_VOLTAGE":                     "voltage_b_phase",
+	"I18N_COMMON_UCA_VOLTAGE":                     "voltage_c_phase",
+	"I18N_COMMON_PHASE_A_CURRENT":                 "phase_a_current",
+	"I18N_COMMON_PHASE_B_CURRENT":                 "phase_b_current",
+	"I18N_COMMON_PHASE_C_CURRENT":                 "phase_c_current",
+	"I18N_COMMON_TOTAL_ACTIVE_POWER":              "total_active_power",
+	"I18N_COMMON_TOTAL_REACTIVE_POWER":            "total_reactive_power",
+	"I18N_COMMON_TOTAL_POWER_FACTOR":              "power_factor",
+	"I18N_COMMON_GRID_FREQUENCY":                  "grid_frequency",
+	"I18N_COMMON_FEED_NETWORK_TOTAL_ACTIVE_POWER": "export_power",
+	"I18N_COMMON_LOAD_TOTAL_ACTIVE_POWER":         "load_power",
+	"I18N_COMMON_DAILY_FEED_NETWORK_VOLUME":       "daily_export_energy",
+	"I18N_COMMON_TOTAL_FEED_NETWORK_VOLUME":       "total_export_energy",
+	"I18N_COMMON_ENERGY_GET_FROM_GRID_DAILY":      "daily_import_energy",
+	"I18N_COMMON_ENERGY_GET_FROM_GRID":            "total_import_energy",
+	"I18N_COMMON_BATTERY_VOLTAGE":                 "battery_voltage",
+	"I18N_COMMON_BATTERY_CURRENT":                 "battery_current",
+	"I18N_COMMON_BATTERY_POWER":                   "battery_power",
+	"I18N_COMMON_BATTERY_SOC":                     "battery_level",
+	"I18N_COMMON_BATTERY_SOH":                     "battery_health",
+	"I18N_COMMON_BATTERY_TEMPERATURE":             "battery_temperature",
+	"I18N_COMMON_DAILY_BATTERY_CHARGE_PV":         "daily_battery_charge_from_pv",
+	"I18N_COMMON_DAILY_BATTERY_DISCHARGE":         "daily_battery_discharge_energy",
+	"I18N_COMMON_TOTAL_BATTERY_DISCHARGE":         "total_battery_discharge_energy",
+	"I18N_COMMON_ISO_RESISTANCE":                  "array_insulation_resistance",
+	"I18N_COMMON_BUS_VOLTAGE":                     "bus_voltage",
+}
+
+// ReadRealtime updates the registers with the values provided by the source,
+// values that can't be mapped onto a register are ignored.
+func (i *Inverter) ReadRealtime(src RealtimeSource) error {
+	values, err := src.Realtime()
+	if err != nil {
+		return err
+	}
+
+	model := src.Model()
+
+	for _, v := range values {
+		reg := i.Registers.Find(realtimeRegisterName(v), model)
+		if reg == nil {
+			continue
+		}
+
+		reg.Supported = true
+		reg.Err = nil
+		reg.RAW = nil
+
+		f, isa := v.Float()
+		if !isa {
+			reg.Value = nil
+			if s := strings.TrimSpace(v.Value); s != "--" && s != "" {
+				reg.Value = s
+			}
+			continue
+		}
+
+		reg.Value = convertUnit(f, v.Unit, reg.GetUnit())
+	}
+
+	i.Compute()
+
+	return nil
+}
+
+func realtimeRegisterName(v transport.RealtimeValue) string {
+	if name, isa := RealtimeNames[v.Name]; isa {
+		return name
+	}
+
+	// MPPT1.voltage -> mppt_1_voltage
+	if v.Service == transport.ServiceDirect {
+		name := strings.ToLower(strings.Replace(v.Name, ".", "_", 1))
+		if strings.HasPrefix(name, "mppt") {
+			return "mppt_" + strings.TrimPrefix(name, "mppt")
+		}
+	}
+
+	return v.Name
+}
+
+// convertUnit handles the websocket reporting kW where the register is in W and the like.
+func convertUnit(v float64, from, to string) float64 {
+	if from == "" || to == "" || strings.EqualFold(from, to) {
+		return v
+	}
+
+	prefixes := map[byte]float64{'k': 1e3, 'K': 1e3, 'M': 1e6}
+
+	if len(from) > 1 && strings.EqualFold(from[1:], to) {
+		if p, isa := prefixes[from[0]]; isa {
+			return v * p
+		}
+	}
+
+	if len(to) > 1 && strings.EqualFold(to[1:], from) {
+		if p, isa := prefixes[to[0]]; isa {
+			return v / p
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,71 @@
+package sungrow_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/transport"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRealtime []transport.RealtimeValue
+
+func (f fakeRealtime) Model() string {
+	return "SH10RT"
+}
+
+func (f fakeRealtime) Realtime() ([]transport.RealtimeValue, error) {
+	return f, nil
+}
+
+func TestReadRealtime(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(`registers:
+  input:
+    - address: 5017
+      name: "total_dc_power"
+      unit: "W"
+      type: "uint32"
+    - address: 5083
+      name: "load_power"
+      type: "int32"
+      unit: "W"
+      models: ["SG10RT"]
+    - address: 5011
+      name: "mppt_1_voltage"
+      unit: "V"
+      scale: 0.1
+    - address: 13008
+      name: "load_power"
+      unit: "W"
+      type: "int32"
+      models: ["SH10RT"]
+    - address: 13023
+      name: "battery_level"
+      unit: "%"
+      scale: 0.1
+`)))
+
+	requires.NoError(inv.ReadRealtime(fakeRealtime{
+		{Service: transport.ServiceReal, Name: "I18N_COMMON_TOTAL_DCPOWER", Value: "4.27", Unit: "kW"},
+		{Service: transport.ServiceReal, Name: "I18N_COMMON_LOAD_TOTAL_ACTIVE_POWER", Value: "512", Unit: "W"},
+		{Service: transport.ServiceReal, Name: "I18N_COMMON_BATTERY_SOC", Value: "--", Unit: "%"},
+		{Service: transport.ServiceReal, Name: "I18N_COMMON_SOMETHING_ELSE", Value: "1", Unit: ""},
+		{Service: transport.ServiceDirect, Name: "MPPT1.voltage", Value: "351.2", Unit: "V"},
+	}))
+
+	requires.True(inv.Registers.Input[0].Supported)
+	requires.InDelta(4270.0, inv.Registers.Input[0].Value, 0.001)
+
+	requires.False(inv.Registers.Input[1].Supported)
+	requires.True(inv.Registers.Input[3].Supported)
+	requires.Equal(512.0, inv.Registers.Input[3].Value)
+
+	requires.Equal(351.2, inv.Registers.Input[2].Value)
+
+	requires.True(inv.Registers.Input[4].Supported)
+	requires.Nil(inv.Registers.Input[4].Value)
+}
@@ -0,0 +1,76 @@
+package sungrow_test
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/transport"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "Rewrite the golden files of the recordings")
+
+// TestRecordings reads every recording in testdata/recordings with the
+// register definition and compares what was decoded to its golden file.
+// Recordings named fake-* were made up to smoke test replay, they aren't
+// captures of real devices.
+func TestRecordings(t *testing.T) {
+	recordings, err := filepath.Glob(filepath.Join("testdata", "recordings", "*.jsonl"))
+	require.NoError(t, err)
+
+	for _, name := range recordings {
+		name := name
+		t.Run(filepath.Base(name), func(t *testing.T) {
+			requires := require.New(t)
+
+			var inv sungrow.Inverter
+			requires.NoError(inv.DefineFromYaml(filepath.Join("maps", "sungrow.yml")))
+
+			client, err := transport.Dial("replay://" + name)
+			requires.NoError(err)
+			defer client.Close()
+
+			requires.NoError(inv.Read(client))
+
+			got := map[string]string{"model": inv.Model()}
+			for bank, regs := range map[string][]sungrow.Register{
+				"input":    inv.Registers.Input,
+				"holding":  inv.Registers.Holding,
+				"computed": inv.Registers.Computed,
+			} {
+				for _, r := range regs {
+					if !r.Supported {
+						continue
+					}
+
+					key := fmt.Sprintf("%s.%d.%s", bank, r.Address, r.Name)
+					if r.Err != nil {
+						got[key] = "error: " + r.Err.Error()
+						continue
+					}
+					got[key] = fmt.Sprint(r.Value)
+				}
+			}
+
+			golden := strings.TrimSuffix(name, ".jsonl") + ".golden.json"
+			if *update {
+				b, err := json.MarshalIndent(got, "", "  ")
+				requires.NoError(err)
+				requires.NoError(os.WriteFile(golden, append(b, '\n'), 0o644))
+			}
+
+			b, err := os.ReadFile(golden)
+			requires.NoError(err, "run go test -run TestRecordings -update to create it")
+
+			var want map[string]string
+			requires.NoError(json.Unmarshal(b, &want))
+			requires.Equal(want, got)
+		})
+	}
+}
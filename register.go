@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"io"
+	"sort"
 
 	"gopkg.in/yaml.v3"
 )
@@ -14,6 +15,7 @@ type Register struct {
 	Unit         *string             `yaml:"unit,omitempty"`
 	Scale        float64             `yaml:"scale,omitempty"`
 	Values       map[int]interface{} `yaml:"values,omitempty"`
+	Bits         map[int]string      `yaml:"bits,omitempty"`
 	Type         string              `yaml:"type,omitempty"`
 	Min          *float64            `yaml:"min,omitempty"`
 	Max          *float64            `yaml:"max,omitempty"`
@@ -21,11 +23,20 @@ type Register struct {
 	Models       Models              `yaml:"models,omitempty"`
 	Validity     *string             `yaml:"validity,omitempty"`
 	Availibility *string             `yaml:"availibility,omitempty"`
+	Formula      string              `yaml:"formula,omitempty"`
+	Faults       string              `yaml:"faults,omitempty"`
+	Default      *float64            `yaml:"default,omitempty"`
 
 	Value     interface{}
 	RAW       []byte
 	Err       error
 	Supported bool
+	Transport string
+
+	catalogue *FaultCatalogue
+	model     string
+	// Parsed Formula
+	expr *Expression
 }
 
 func (r *Register) UnmarshalYAML(value *yaml.Node) error {
@@ -44,6 +55,11 @@ func (r *Register) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// Size returns how many 16 bit registers the register spans.
+func (r *Register) Size() int {
+	return r.sizeAs16Bit()
+}
+
 func (r *Register) sizeAs16Bit() int {
 	sz := 1
 
@@ -65,36 +81,43 @@ func (r *Register) read(rdr io.Reader) (err error) {
 	var buf bytes.Buffer
 	reader := io.TeeReader(rdr, &buf)
 
+	// Raw value for the fault catalogue
+	var code uint32
+
 	switch r.Type {
 	case "int16":
 		var read int16
 		err = binary.Read(reader, binary.BigEndian, &read)
+		code = uint32(uint16(read))
 		r.Value = float64(read) * r.Scale
 	case "uint16":
 		var read uint16
 		err = binary.Read(reader, binary.BigEndian, &read)
+		code = uint32(read)
 		r.Value = float64(read) * r.Scale
 	case "int32":
-		var read int32
-		err = binary.Read(reader, binary.LittleEndian, &read)
-		r.Value = float64(read) * r.Scale
+		var read uint32
+		read, err = readUint32(reader)
+		code = read
+		r.Value = float64(int32(read)) * r.Scale
 	case "uint32":
 		var read uint32
-		err = binary.Read(reader, binary.LittleEndian, &read)
+		read, err = readUint32(reader)
+		code = read
 		r.Value = float64(read) * r.Scale
 	case "string":
 		b := make([]byte, r.sizeAs16Bit()*2)
 		_, err = reader.Read(b)
 
-		for i, v := range b {
-			if v == 00 {
-				r.Value = string(b[0:i])
-				break
-			}
+		if i := bytes.IndexByte(b, 0); i >= 0 {
+			b = b[:i]
 		}
+		r.Value = string(b)
 	}
 
-	if r.Values != nil {
+	if r.catalogue != nil && r.Type != "string" {
+		r.Value = r.catalogue.decode(code, r.model)
+	} else if r.Values != nil {
 		switch z := r.Value.(type) {
 		case float64:
 			r.Value = r.Values[int(z)]
@@ -109,6 +132,66 @@ func (r *Register) read(rdr io.Reader) (err error) {
 	return nil
 }
 
+// Decode sets the value of the register from data as read from the device.
+func (r *Register) Decode(data []byte) error {
+	return r.read(bytes.NewReader(data))
+}
+
+// readUint32 reads a 32 bit value, Sungrow sends the low word first.
+func readUint32(rdr io.Reader) (uint32, error) {
+	var words [2]uint16
+	if err := binary.Read(rdr, binary.BigEndian, &words); err != nil {
+		return 0, err
+	}
+
+	return uint32(words[1])<<16 | uint32(words[0]), nil
+}
+
+// Float returns the numeric value of the register, decoding the raw value
+// when the value has been replaced by a label.
+func (r *Register) Float() (float64, bool) {
+	if f, isa := r.Value.(float64); isa {
+		return f, true
+	}
+
+	if len(r.RAW) == 0 || r.Type == "string" {
+		return 0, false
+	}
+
+	raw := *r
+	raw.Values = nil
+	raw.catalogue = nil
+	if err := raw.read(bytes.NewReader(r.RAW)); err != nil {
+		return 0, false
+	}
+
+	f, isa := raw.Value.(float64)
+	return f, isa
+}
+
+// Flags returns the labels of the bits set in the register, lowest bit first.
+func (r *Register) Flags() []string {
+	v, isa := r.Float()
+	if !isa || len(r.Bits) == 0 {
+		return nil
+	}
+
+	var masks []int
+	for mask := range r.Bits {
+		if int(v)&mask != 0 {
+			masks = append(masks, mask)
+		}
+	}
+	sort.Ints(masks)
+
+	flags := make([]string, len(masks))
+	for i, mask := range masks {
+		flags[i] = r.Bits[mask]
+	}
+
+	return flags
+}
+
 func (r *Register) GetUnit() string {
 	if r.Unit == nil {
 		return ""
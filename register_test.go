@@ -37,3 +37,16 @@ func TestUnmarshleRegister(t *testing.T) {
 	requires.Equal(0.01, registers[1].Scale)
 	requires.Equal(1.0, registers[2].Scale)
 }
+
+func TestDecode32Bit(t *testing.T) {
+	requires := require.New(t)
+
+	// Sungrow sends the low word first, each word is big endian
+	r := sungrow.Register{Type: "uint32", Scale: 1}
+	requires.NoError(r.Decode([]byte{0x23, 0x45, 0x00, 0x01}))
+	requires.Equal(float64(0x12345), r.Value)
+
+	r = sungrow.Register{Type: "int32", Scale: 0.1}
+	requires.NoError(r.Decode([]byte{0xFF, 0xFE, 0xFF, 0xFF}))
+	requires.InDelta(-0.2, r.Value, 1e-9)
+}
@@ -1,11 +1,35 @@
 package sungrow
 
 type Registers struct {
-	Input   []Register `yaml:"input,omitempty"`
-	Holding []Register `yaml:"holding,omitempty"`
+	Input    []Register `yaml:"input,omitempty"`
+	Holding  []Register `yaml:"holding,omitempty"`
+	Computed []Register `yaml:"computed,omitempty"`
 }
 
 func (r *Registers) Clear() {
 	r.Input = []Register{}
 	r.Holding = []Register{}
+	r.Computed = []Register{}
+}
+
+// All returns the input, holding and computed registers in that order.
+func (r *Registers) All() []Register {
+	all := make([]Register, 0, len(r.Input)+len(r.Holding)+len(r.Computed))
+	all = append(all, r.Input...)
+	all = append(all, r.Holding...)
+	return append(all, r.Computed...)
+}
+
+// Find returns the first register by the given name that applies to the model,
+// input registers are searched before holding and computed registers.
+func (r *Registers) Find(name, model string) *Register {
+	for _, set := range [][]Register{r.Input, r.Holding, r.Computed} {
+		for i := range set {
+			if set[i].Name == name && (model == "" || set[i].Models.ContainsOrNull(model)) {
+				return &set[i]
+			}
+		}
+	}
+
+	return nil
 }
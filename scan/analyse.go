@@ -0,0 +1,373 @@
+package scan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/freman/sungrow"
+)
+
+// Stability of the samples of an address.
+const (
+	StabilityZero     = "zero"
+	StabilityConstant = "constant"
+	StabilityCounter  = "counter"
+	StabilityChanging = "changing"
+)
+
+// Finding is a register, known or guessed, in the scanned address space.
+type Finding struct {
+	Holding bool
+	Address int
+	// 16 bit registers it spans
+	Size int
+	Type string
+	// Name in the definition, empty for registers it doesn't know
+	Known     string
+	Stability string
+	// Decoded samples, numbers or strings
+	Values []interface{}
+}
+
+// Analyse guesses the registers behind the answered addresses, found must be
+// in address order as returned by Scan. A number the scan starts part way
+// through is reported as the uint16 words left of it.
+func Analyse(found []Address, inv *sungrow.Inverter) []Finding {
+	known := covered(inv)
+
+	var findings []Finding
+	for i := 0; i < len(found); {
+		a := found[i]
+		if a.Status != Answered {
+			i++
+			continue
+		}
+
+		f := Finding{Holding: a.Holding, Address: a.Address, Size: 1, Type: "uint16"}
+
+		if r, isa := known[key{a.Holding, a.Address}]; isa {
+			f.Known, f.Type = r.Name, r.Type
+			// The scan may have started part way through it
+			f.Size = r.Address + r.Size() - a.Address
+			if f.Type == "" || f.Type != "string" && a.Address != r.Address {
+				// The words left of a number are just words
+				f.Type = "uint16"
+			}
+		} else {
+			f.Type, f.Size = guess(found[i:], known)
+		}
+
+		f.Values = decode(found[i:], f.Type, f.Size)
+		f.Stability = stability(f.Values)
+		findings = append(findings, f)
+
+		// Skip the rest of the register, so long as it's all there
+		next := i + 1
+		for next < i+f.Size && next < len(found) && found[next].Status == Answered {
+			next++
+		}
+		i = next
+	}
+
+	return findings
+}
+
+type key struct {
+	holding bool
+	address int
+}
+
+// covered maps every address the definition knows of, for any model, to its register.
+func covered(inv *sungrow.Inverter) map[key]sungrow.Register {
+	known := map[key]sungrow.Register{}
+	if inv == nil {
+		return known
+	}
+
+	for bank, regs := range map[bool][]sungrow.Register{false: inv.Registers.Input, true: inv.Registers.Holding} {
+		for _, r := range regs {
+			for w := 0; w < r.Size(); w++ {
+				k := key{bank, r.Address + w}
+				if _, isa := known[k]; !isa || w == 0 {
+					known[k] = r
+				}
+			}
+		}
+	}
+
+	return known
+}
+
+// guess works out the type of the register starting at the first address.
+func guess(run []Address, known map[key]sungrow.Register) (string, int) {
+	// The words that follow on, answered and unknown
+	n := 1
+	for n < len(run) && run[n].Status == Answered &&
+		run[n].Address == run[n-1].Address+1 && run[n].Holding == run[0].Holding {
+		if _, isa := known[key{run[n].Holding, run[n].Address}]; isa {
+			break
+		}
+		n++
+	}
+
+	if count := text(run[:n]); count >= 2 {
+		return "string", count
+	}
+
+	lo := run[0].Samples
+	if n >= 2 {
+		hi := run[1].Samples
+		switch {
+		case all(hi, 0) && some(lo, func(v uint16) bool { return v != 0 }) && !signed(lo):
+			// Could be two uint16, Sungrow's 32 bit registers are far more common
+			return "uint32", 2
+		case all(hi, 0xFFFF) || mixedSign(lo, hi):
+			return "int32", 2
+		case some(hi, func(v uint16) bool { return v != 0 && v < 0x100 }) && changes(lo):
+			// A counter that's past 65535
+			return "uint32", 2
+		}
+	}
+
+	if signed(lo) {
+		return "int16", 1
+	}
+
+	return "uint16", 1
+}
+
+// text returns how many of the words hold printable ASCII, zero padded.
+func text(run []Address) int {
+	letters := 0
+	count := 0
+	for _, a := range run {
+		if len(a.Samples) == 0 {
+			break
+		}
+
+		v := a.Samples[0]
+		b1, b2 := byte(v>>8), byte(v)
+		if !printable(b1) || !printable(b2) || v == 0 && letters == 0 {
+			break
+		}
+
+		for _, s := range a.Samples[1:] {
+			if s != v {
+				return 0
+			}
+		}
+
+		for _, b := range []byte{b1, b2} {
+			if b >= 'A' && b <= 'Z' || b >= 'a' && b <= 'z' || b >= '0' && b <= '9' {
+				letters++
+			}
+		}
+		count++
+	}
+
+	if letters < 3 {
+		return 0
+	}
+
+	return count
+}
+
+func printable(b byte) bool {
+	return b == 0 || b >= 0x20 && b < 0x7f
+}
+
+func all(samples []uint16, v uint16) bool {
+	for _, s := range samples {
+		if s != v {
+			return false
+		}
+	}
+	return len(samples) > 0
+}
+
+func some(samples []uint16, fn func(uint16) bool) bool {
+	for _, s := range samples {
+		if fn(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func changes(samples []uint16) bool {
+	for _, s := range samples[1:] {
+		if s != samples[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// signed reports values just under 0x10000, small negative numbers
+func signed(samples []uint16) bool {
+	return some(samples, func(v uint16) bool { return v >= 0xF000 })
+}
+
+// mixedSign reports a high word that follows the sign of the low word
+func mixedSign(lo, hi []uint16) bool {
+	if len(lo) != len(hi) || !some(hi, func(v uint16) bool { return v == 0xFFFF }) {
+		return false
+	}
+
+	for i := range hi {
+		if hi[i] != 0 && hi[i] != 0xFFFF {
+			return false
+		}
+	}
+	return true
+}
+
+func decode(run []Address, typ string, size int) []interface{} {
+	if len(run) < size || (typ == "uint32" || typ == "int32") && len(run) < 2 {
+		return nil
+	}
+
+	samples := len(run[0].Samples)
+	for _, a := range run[:size] {
+		if len(a.Samples) < samples {
+			samples = len(a.Samples)
+		}
+	}
+
+	values := make([]interface{}, samples)
+	for s := 0; s < samples; s++ {
+		switch typ {
+		case "int16":
+			values[s] = float64(int16(run[0].Samples[s]))
+		case "uint32":
+			values[s] = float64(uint32(run[1].Samples[s])<<16 | uint32(run[0].Samples[s]))
+		case "int32":
+			values[s] = float64(int32(uint32(run[1].Samples[s])<<16 | uint32(run[0].Samples[s])))
+		case "string":
+			var b []byte
+			for _, a := range run[:size] {
+				b = append(b, byte(a.Samples[s]>>8), byte(a.Samples[s]))
+			}
+			values[s] = strings.TrimRight(string(b), "\x00")
+		default:
+			values[s] = float64(run[0].Samples[s])
+		}
+	}
+
+	return values
+}
+
+func stability(values []interface{}) string {
+	if len(values) == 0 {
+		return StabilityConstant
+	}
+
+	zero, constant, rising := true, true, true
+	for i, v := range values {
+		if f, isa := v.(float64); !isa || f != 0 {
+			zero = false
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		if v != values[i-1] {
+			constant = false
+		}
+
+		a, aok := values[i-1].(float64)
+		b, bok := v.(float64)
+		if !aok || !bok || b < a {
+			rising = false
+		}
+	}
+
+	switch {
+	case zero:
+		return StabilityZero
+	case constant:
+		return StabilityConstant
+	case rising:
+		return StabilityCounter
+	}
+	return StabilityChanging
+}
+
+// WriteReport writes a line per finding.
+func WriteReport(w io.Writer, findings []Finding) {
+	for _, f := range findings {
+		bank := "input"
+		if f.Holding {
+			bank = "holding"
+		}
+
+		name := f.Known
+		if name == "" {
+			name = "?"
+		}
+
+		fmt.Fprintf(w, "%-7s %5d %-7s %-9s %-40s %s\n", bank, f.Address, f.Type, f.Stability, name, summarise(f.Values))
+	}
+}
+
+// WriteStub writes a register definition of the findings the definition
+// doesn't know, those that only ever read zero are left out unless zeros is set.
+func WriteStub(w io.Writer, findings []Finding, zeros bool) {
+	fmt.Fprintln(w, "registers:")
+
+	for _, holding := range []bool{false, true} {
+		bank := "input"
+		if holding {
+			bank = "holding"
+		}
+
+		header := false
+		for _, f := range findings {
+			if f.Holding != holding || f.Known != "" || f.Stability == StabilityZero && !zeros {
+				continue
+			}
+
+			if !header {
+				fmt.Fprintf(w, "  %s:\n", bank)
+				header = true
+			}
+
+			fmt.Fprintf(w, "    - address: %d\n", f.Address)
+			fmt.Fprintf(w, "      name: \"unknown_%s_%d\" # %s: %s\n", bank, f.Address, f.Stability, summarise(f.Values))
+			if f.Type != "uint16" {
+				fmt.Fprintf(w, "      type: %q\n", f.Type)
+			}
+			if f.Type == "string" {
+				fmt.Fprintf(w, "      count: %d\n", f.Size)
+			}
+		}
+	}
+}
+
+func summarise(values []interface{}) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	if _, isa := values[0].(string); isa {
+		return fmt.Sprintf("%q", values[0])
+	}
+
+	min, max := values[0].(float64), values[0].(float64)
+	for _, v := range values[1:] {
+		f := v.(float64)
+		if f < min {
+			min = f
+		}
+		if f > max {
+			max = f
+		}
+	}
+
+	if min == max {
+		return fmt.Sprint(min)
+	}
+	return fmt.Sprintf("%v..%v", min, max)
+}
@@ -0,0 +1,233 @@
+// Package scan walks the register address space looking for registers the
+// definition doesn't know about.
+package scan
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/goburrow/modbus"
+)
+
+const (
+	defaultMaxBlock = 64
+	defaultRetries  = 2
+
+	// Consecutive transport failures before giving up on the scan
+	maxFailures = 10
+)
+
+// Status is how an address responded.
+type Status int
+
+const (
+	// Unknown addresses weren't scanned, or the transport failed every time
+	Unknown Status = iota
+	// Answered addresses returned a value
+	Answered
+	// Exception addresses were refused by the device
+	Exception
+)
+
+func (s Status) String() string {
+	switch s {
+	case Answered:
+		return "answered"
+	case Exception:
+		return "exception"
+	}
+	return "unknown"
+}
+
+// Range is an inclusive span of register addresses, as in the definition.
+type Range struct {
+	Holding bool
+	Start   int
+	End     int
+}
+
+func (r Range) String() string {
+	bank := "input"
+	if r.Holding {
+		bank = "holding"
+	}
+	return fmt.Sprintf("%s %d-%d", bank, r.Start, r.End)
+}
+
+// Address is what was found at one address.
+type Address struct {
+	Holding bool
+	Address int
+	Status  Status
+	// Exception code when refused
+	Exception byte
+	// Value of each sample
+	Samples []uint16
+}
+
+// Scanner probes a device's registers.
+type Scanner struct {
+	Client sungrow.Modbus
+	// Largest block to read at once
+	MaxBlock int
+	// Attempts at a block the transport fails on
+	Retries int
+	// Pause between requests
+	Delay  time.Duration
+	Logger *log.Logger
+}
+
+// New allocates a Scanner.
+func New(client sungrow.Modbus) *Scanner {
+	return &Scanner{
+		Client:   client,
+		MaxBlock: defaultMaxBlock,
+		Retries:  defaultRetries,
+	}
+}
+
+// Scan finds which addresses in the range answer. Blocks grow while reads
+// succeed and halve on exceptions until the refused address is found.
+func (s *Scanner) Scan(r Range) ([]Address, error) {
+	found := make([]Address, 0, r.End-r.Start+1)
+	for a := r.Start; a <= r.End; a++ {
+		found = append(found, Address{Holding: r.Holding, Address: a})
+	}
+
+	block := 1
+	failures := 0
+	for a := r.Start; a <= r.End; {
+		n := block
+		if a+n-1 > r.End {
+			n = r.End - a + 1
+		}
+
+		results, err := s.read(r.Holding, a, n)
+
+		var mbErr *modbus.ModbusError
+		switch {
+		case err == nil:
+			failures = 0
+			for i := 0; i < n && 2*i+1 < len(results); i++ {
+				found[a-r.Start+i].Status = Answered
+				found[a-r.Start+i].Samples = []uint16{uint16(results[2*i])<<8 | uint16(results[2*i+1])}
+			}
+			a += n
+			if block*2 <= s.maxBlock() {
+				block *= 2
+			}
+		case errors.As(err, &mbErr):
+			failures = 0
+			if n > 1 {
+				block = n / 2
+				continue
+			}
+			found[a-r.Start].Status = Exception
+			found[a-r.Start].Exception = mbErr.ExceptionCode
+			a++
+		default:
+			failures++
+			if failures >= maxFailures {
+				return found, fmt.Errorf("scan of %s stopped at %d: %w", r, a, err)
+			}
+
+			// Try again smaller, then move on
+			if n > 1 {
+				block = n / 2
+				continue
+			}
+			s.logf("scan: %d: %v", a, err)
+			a++
+		}
+	}
+
+	return found, nil
+}
+
+// Sample reads the answered addresses again, count times interval apart,
+// adding to their samples.
+func (s *Scanner) Sample(found []Address, count int, interval time.Duration) error {
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			time.Sleep(interval)
+		}
+
+		for start := 0; start < len(found); {
+			if found[start].Status != Answered {
+				start++
+				continue
+			}
+
+			// Runs of answered addresses can be read together
+			end := start + 1
+			for end < len(found) && end-start < s.maxBlock() &&
+				found[end].Status == Answered &&
+				found[end].Holding == found[start].Holding &&
+				found[end].Address == found[end-1].Address+1 {
+				end++
+			}
+
+			results, err := s.read(found[start].Holding, found[start].Address, end-start)
+			if err != nil {
+				var mbErr *modbus.ModbusError
+				if !errors.As(err, &mbErr) {
+					return err
+				}
+				// Changed its mind, try again next time
+				start = end
+				continue
+			}
+
+			for j := start; j < end && 2*(j-start)+1 < len(results); j++ {
+				o := 2 * (j - start)
+				found[j].Samples = append(found[j].Samples, uint16(results[o])<<8|uint16(results[o+1]))
+			}
+			start = end
+		}
+	}
+
+	return nil
+}
+
+func (s *Scanner) read(holding bool, address, quantity int) (results []byte, err error) {
+	fn := s.Client.ReadInputRegisters
+	if holding {
+		fn = s.Client.ReadHoldingRegisters
+	}
+
+	retries := s.Retries
+	if retries < 1 {
+		retries = 1
+	}
+
+	for try := 0; try < retries; try++ {
+		if s.Delay > 0 {
+			time.Sleep(s.Delay)
+		}
+
+		results, err = fn(uint16(address-1), uint16(quantity))
+
+		var mbErr *modbus.ModbusError
+		if err == nil || errors.As(err, &mbErr) {
+			return
+		}
+	}
+
+	return
+}
+
+func (s *Scanner) maxBlock() int {
+	if s.MaxBlock < 1 {
+		return 1
+	}
+	return s.MaxBlock
+}
+
+func (s *Scanner) logf(format string, v ...interface{}) {
+	if s.Logger != nil {
+		s.Logger.Printf(format, v...)
+	}
+}
@@ -0,0 +1,180 @@
+package scan_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/scan"
+	"github.com/goburrow/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+const registers = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+    - address: 5003
+      name: "daily_output_energy"
+      scale: 0.1
+`
+
+// fakeInverter refuses any block that touches an address it doesn't have,
+// like the real thing
+type fakeInverter struct {
+	input map[uint16]uint16
+	reads int
+	tick  uint16
+}
+
+func (f *fakeInverter) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	f.reads++
+
+	var results []byte
+	for i := uint16(0); i < quantity; i++ {
+		v, isa := f.input[address+i]
+		if !isa {
+			return nil, &modbus.ModbusError{FunctionCode: 0x84, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}
+		}
+
+		// The power moves about between samples
+		if address+i == 5009 {
+			v += f.tick
+		}
+		results = append(results, byte(v>>8), byte(v))
+	}
+
+	if address <= 5009 && address+quantity > 5009 {
+		f.tick += 7
+	}
+
+	return results, nil
+}
+
+func (f *fakeInverter) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return nil, &modbus.ModbusError{FunctionCode: 0x83, ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}
+}
+
+func newFake() *fakeInverter {
+	f := &fakeInverter{input: map[uint16]uint16{
+		4999: 0x147,
+		5000: 0,
+		5001: 0,
+		5002: 123,
+		// Serial number
+		5003: 'A'<<8 | '1', 5004: 'B'<<8 | '2', 5005: 'C'<<8 | '3', 5006: 0,
+		// Negative int32, low word first
+		5007: 0xFF38, 5008: 0xFFFF,
+		5009: 2000,
+	}}
+
+	for a := uint16(5019); a < 5100; a++ {
+		f.input[a] = 0
+	}
+	return f
+}
+
+func TestScan(t *testing.T) {
+	requires := require.New(t)
+
+	fake := newFake()
+	s := scan.New(fake)
+	s.MaxBlock = 32
+
+	found, err := s.Scan(scan.Range{Start: 4995, End: 5100})
+	requires.NoError(err)
+	requires.Len(found, 106)
+
+	answered := 0
+	for _, a := range found {
+		_, isa := fake.input[uint16(a.Address-1)]
+		if isa {
+			requires.Equal(scan.Answered, a.Status, "address %d", a.Address)
+			answered++
+		} else {
+			requires.Equal(scan.Exception, a.Status, "address %d", a.Address)
+			requires.Equal(byte(modbus.ExceptionCodeIllegalDataAddress), a.Exception)
+		}
+	}
+	requires.Equal(len(fake.input), answered)
+
+	// Far fewer reads than addresses
+	requires.Less(fake.reads, 60)
+
+	requires.NoError(s.Sample(found, 3, 0))
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(registers)))
+
+	findings := scan.Analyse(found, &inv)
+
+	byAddress := map[int]scan.Finding{}
+	for _, f := range findings {
+		byAddress[f.Address] = f
+	}
+
+	requires.Equal("device_type_code", byAddress[5000].Known)
+	requires.Equal(scan.StabilityConstant, byAddress[5000].Stability)
+
+	requires.Equal("uint16", byAddress[5003].Type)
+	requires.Equal("daily_output_energy", byAddress[5003].Known)
+
+	requires.Equal("string", byAddress[5004].Type)
+	requires.Equal(4, byAddress[5004].Size)
+	requires.Equal("A1B2C3", byAddress[5004].Values[0])
+
+	requires.Equal("int32", byAddress[5008].Type)
+	requires.Equal(-200.0, byAddress[5008].Values[0])
+
+	requires.Equal(scan.StabilityCounter, byAddress[5010].Stability)
+	requires.Len(byAddress[5010].Values, 4)
+
+	requires.Equal(scan.StabilityZero, byAddress[5020].Stability)
+
+	var report, stub bytes.Buffer
+	scan.WriteReport(&report, findings)
+	requires.Contains(report.String(), "device_type_code")
+
+	scan.WriteStub(&stub, findings, false)
+	requires.Contains(stub.String(), "- address: 5008\n      name: \"unknown_input_5008\"")
+	requires.Contains(stub.String(), "type: \"int32\"")
+	requires.NotContains(stub.String(), "address: 5000\n")
+	requires.NotContains(stub.String(), "address: 5020\n")
+
+	var defined sungrow.Inverter
+	requires.NoError(defined.Define(&stub))
+	requires.NotEmpty(defined.Registers.Input)
+}
+
+func TestAnalyseMidRegister(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(`registers:
+  input:
+    - address: 5017
+      name: "total_dc_power"
+      type: "uint32"
+    - address: 5019
+      name: "phase_a_voltage"
+`)))
+
+	// The range starts on the high word of total_dc_power
+	high := scan.Address{Address: 5018, Status: scan.Answered, Samples: []uint16{1, 1}}
+	voltage := scan.Address{Address: 5019, Status: scan.Answered, Samples: []uint16{2400, 2401}}
+
+	for _, found := range [][]scan.Address{{high}, {high, voltage}} {
+		findings := scan.Analyse(found, &inv)
+		requires.Len(findings, len(found))
+
+		requires.Equal(scan.Finding{
+			Address:   5018,
+			Size:      1,
+			Type:      "uint16",
+			Known:     "total_dc_power",
+			Stability: scan.StabilityConstant,
+			Values:    []interface{}{1.0, 1.0},
+		}, findings[0])
+	}
+}
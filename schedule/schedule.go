@@ -0,0 +1,209 @@
+// Package schedule drives forced battery charging and discharging on hybrid
+// inverters from a time-of-use schedule.
+package schedule
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Mode of a window
+type Mode string
+
+const (
+	ModeCharge    Mode = "charge"
+	ModeDischarge Mode = "discharge"
+)
+
+// Schedule is the YAML schedule, eg
+//
+//	timezone: Australia/Brisbane
+//	windows:
+//	  - name: overnight
+//	    start: "00:30"
+//	    end: "05:30"
+//	    mode: charge
+//	    power: 3000
+//	    target_soc: 90
+//	  - name: evening
+//	    start: "16:00"
+//	    end: "21:00"
+//	    days: [mon, tue, wed, thu, fri]
+//	    mode: discharge
+//	    power: 2500
+//	    target_soc: 20
+type Schedule struct {
+	Timezone string   `yaml:"timezone,omitempty"`
+	Windows  []Window `yaml:"windows"`
+
+	location *time.Location
+}
+
+// Window forces the battery between Start and End, windows that end before
+// they start run over midnight.
+type Window struct {
+	Name  string `yaml:"name"`
+	Start Clock  `yaml:"start"`
+	End   Clock  `yaml:"end"`
+	// Days the window starts on, every day if empty
+	Days []string `yaml:"days,omitempty"`
+	Mode Mode     `yaml:"mode"`
+	// Charge or discharge power in W
+	Power float64 `yaml:"power"`
+	// Stop charging at or above, or discharging at or below, this SOC. It is
+	// written to max_soc or min_soc for the window so the inverter holds it
+	// between checks, when the model has them and the target is in range.
+	TargetSOC float64 `yaml:"target_soc,omitempty"`
+}
+
+// Clock is a time of day in minutes past midnight.
+type Clock int
+
+func (c *Clock) UnmarshalYAML(value *yaml.Node) error {
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return fmt.Errorf("invalid time of day %q", s)
+	}
+
+	*c = Clock(t.Hour()*60 + t.Minute())
+	return nil
+}
+
+func (c Clock) String() string {
+	return fmt.Sprintf("%02d:%02d", int(c)/60, int(c)%60)
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Load reads and validates a schedule.
+func Load(r io.Reader) (*Schedule, error) {
+	var s Schedule
+	if err := yaml.NewDecoder(r).Decode(&s); err != nil {
+		return nil, err
+	}
+
+	return &s, s.validate()
+}
+
+// LoadFile reads and validates a schedule file.
+func LoadFile(name string) (*Schedule, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return Load(f)
+}
+
+func (s *Schedule) validate() error {
+	s.location = time.Local
+	if s.Timezone != "" {
+		loc, err := time.LoadLocation(s.Timezone)
+		if err != nil {
+			return err
+		}
+		s.location = loc
+	}
+
+	for _, w := range s.Windows {
+		if w.Mode != ModeCharge && w.Mode != ModeDischarge {
+			return fmt.Errorf("window %q has unknown mode %q", w.Name, w.Mode)
+		}
+
+		if w.Start == w.End {
+			return fmt.Errorf("window %q starts when it ends", w.Name)
+		}
+
+		if w.Power <= 0 {
+			return fmt.Errorf("window %q needs a power", w.Name)
+		}
+
+		for _, d := range w.Days {
+			if _, isa := weekdays[strings.ToLower(d)]; !isa {
+				return fmt.Errorf("window %q has unknown day %q", w.Name, d)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Active returns the window in effect at t, if any, the first listed wins.
+func (s *Schedule) Active(t time.Time) *Window {
+	if s.location != nil {
+		t = t.In(s.location)
+	}
+
+	now := Clock(t.Hour()*60 + t.Minute())
+
+	for idx := range s.Windows {
+		w := &s.Windows[idx]
+
+		day := t.Weekday()
+		switch {
+		case w.Start < w.End:
+			if now < w.Start || now >= w.End {
+				continue
+			}
+		case now >= w.Start:
+		case now < w.End:
+			// Started yesterday
+			day = (day + 6) % 7
+		default:
+			continue
+		}
+
+		if w.on(day) {
+			return w
+		}
+	}
+
+	return nil
+}
+
+func (w *Window) on(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+
+	for _, d := range w.Days {
+		if weekdays[strings.ToLower(d)] == day {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Done reports whether the window's target has been reached at the given SOC.
+func (w *Window) Done(soc float64) bool {
+	if w.TargetSOC <= 0 {
+		return false
+	}
+
+	if w.Mode == ModeCharge {
+		return soc >= w.TargetSOC
+	}
+
+	return soc <= w.TargetSOC
+}
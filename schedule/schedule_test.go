@@ -0,0 +1,168 @@
+package schedule_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/internal/modbustest"
+	"github.com/freman/sungrow/schedule"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchedule = `timezone: UTC
+windows:
+  - name: overnight
+    start: "23:30"
+    end: "05:30"
+    mode: charge
+    power: 3000
+    target_soc: 90
+  - name: evening
+    start: "16:00"
+    end: "21:00"
+    days: [mon, tue, wed, thu, fri]
+    mode: discharge
+    power: 2500
+    target_soc: 20
+`
+
+func TestActive(t *testing.T) {
+	requires := require.New(t)
+
+	s, err := schedule.Load(strings.NewReader(testSchedule))
+	requires.NoError(err)
+
+	// Friday the 3rd of November 2023
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2023, 11, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	requires.Nil(s.Active(at(3, 12, 0)))
+	requires.Equal("evening", s.Active(at(3, 16, 0)).Name)
+	requires.Nil(s.Active(at(3, 21, 0)))
+	requires.Nil(s.Active(at(4, 17, 0)))
+	requires.Equal("overnight", s.Active(at(3, 23, 45)).Name)
+	requires.Equal("overnight", s.Active(at(4, 5, 29)).Name)
+	requires.Nil(s.Active(at(4, 5, 30)))
+
+	for _, bad := range []string{
+		"windows:\n  - {name: x, start: \"01:00\", end: \"02:00\", mode: idle, power: 1}\n",
+		"windows:\n  - {name: x, start: \"01:00\", end: \"01:00\", mode: charge, power: 1}\n",
+		"windows:\n  - {name: x, start: \"25:00\", end: \"01:00\", mode: charge, power: 1}\n",
+		"windows:\n  - {name: x, start: \"01:00\", end: \"02:00\", mode: charge}\n",
+		"windows:\n  - {name: x, start: \"01:00\", end: \"02:00\", mode: charge, power: 1, days: [someday]}\n",
+		"timezone: Nowhere/Special\n",
+	} {
+		_, err := schedule.Load(strings.NewReader(bad))
+		requires.Error(err, bad)
+	}
+}
+
+const registers = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0xE03:
+          hybrid: true
+          name: "SH10RT"
+    - address: 13023
+      name: "battery_level"
+      unit: "%"
+      scale: 0.1
+  holding:
+    - address: 13050
+      name: "ems_mode_selection"
+      default: 0
+    - address: 13051
+      name: "charge_discharge_command"
+      default: 0xCC
+    - address: 13052
+      name: "charge_discharge_power"
+      default: 1000
+`
+
+func TestScheduler(t *testing.T) {
+	requires := require.New(t)
+
+	s, err := schedule.Load(strings.NewReader(testSchedule))
+	requires.NoError(err)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(registers)))
+
+	client := &modbustest.Device{
+		Input:   map[uint16]uint16{4999: 0xE03, 13022: 500},
+		Holding: map[uint16]uint16{13049: 0, 13050: 0xCC, 13051: 1000},
+	}
+
+	scheduler := schedule.NewScheduler(&inv, client, s)
+
+	// Friday evening, discharging
+	requires.NoError(scheduler.Step(time.Date(2023, 11, 3, 16, 0, 0, 0, time.UTC)))
+	requires.Equal(map[uint16]uint16{13049: sungrow.EMSModeForced, 13050: sungrow.CommandDischarge, 13051: 2500}, client.Holding)
+
+	// Nothing changes, nothing written
+	client.Writes = 0
+	requires.NoError(scheduler.Step(time.Date(2023, 11, 3, 16, 1, 0, 0, time.UTC)))
+	requires.Equal(0, client.Writes)
+
+	// Target reached
+	client.Input[13022] = 200
+	requires.NoError(scheduler.Step(time.Date(2023, 11, 3, 18, 0, 0, 0, time.UTC)))
+	requires.Equal(uint16(sungrow.EMSModeSelfConsumption), client.Holding[13049])
+	requires.Equal(uint16(sungrow.CommandStop), client.Holding[13050])
+
+	// Overnight charge, but the power doesn't stick
+	// The inverter ignores forced charging power while broken
+	client.Ignored = map[uint16]bool{13051: true}
+	err = scheduler.Step(time.Date(2023, 11, 4, 1, 0, 0, 0, time.UTC))
+	requires.ErrorIs(err, sungrow.ErrVerify)
+	requires.Equal(uint16(sungrow.EMSModeSelfConsumption), client.Holding[13049])
+	requires.Equal(uint16(sungrow.CommandStop), client.Holding[13050])
+}
+
+const limitRegisters = registers + `    - address: 13058
+      name: "max_soc"
+      min: 70.0
+      max: 100.0
+      scale: 0.1
+    - address: 13059
+      name: "min_soc"
+      min: 0.0
+      max: 50.0
+      scale: 0.1
+`
+
+func TestSchedulerSOCLimits(t *testing.T) {
+	requires := require.New(t)
+
+	s, err := schedule.Load(strings.NewReader(testSchedule))
+	requires.NoError(err)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(limitRegisters)))
+
+	client := &modbustest.Device{
+		Input:   map[uint16]uint16{4999: 0xE03, 13022: 500},
+		Holding: map[uint16]uint16{13049: 0, 13050: 0xCC, 13051: 1000, 13057: 1000, 13058: 50},
+	}
+
+	scheduler := schedule.NewScheduler(&inv, client, s)
+
+	// The inverter stops discharging at the target itself
+	requires.NoError(scheduler.Step(time.Date(2023, 11, 3, 16, 0, 0, 0, time.UTC)))
+	requires.Equal(uint16(200), client.Holding[13058])
+	requires.Equal(uint16(1000), client.Holding[13057])
+
+	// Overnight charge swaps the limits over
+	requires.NoError(scheduler.Step(time.Date(2023, 11, 4, 1, 0, 0, 0, time.UTC)))
+	requires.Equal(uint16(50), client.Holding[13058])
+	requires.Equal(uint16(900), client.Holding[13057])
+
+	// And they're put back after
+	requires.NoError(scheduler.Step(time.Date(2023, 11, 4, 12, 0, 0, 0, time.UTC)))
+	requires.Equal(map[uint16]uint16{13049: sungrow.EMSModeSelfConsumption, 13050: sungrow.CommandStop, 13051: 3000, 13057: 1000, 13058: 50}, client.Holding)
+}
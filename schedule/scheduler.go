@@ -0,0 +1,255 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/freman/sungrow"
+)
+
+const defaultInterval = time.Minute
+
+// Registers read each step, everything else is skipped
+var readRegisters = map[string]bool{
+	"device_type_code": true,
+	"battery_level":    true,
+	"max_soc":          true,
+	"min_soc":          true,
+}
+
+// Scheduler applies the schedule to an inverter.
+type Scheduler struct {
+	Inverter *sungrow.Inverter
+	Client   sungrow.ModbusWriter
+	Schedule *Schedule
+	// How often to check the schedule and SOC
+	Interval time.Duration
+	Logger   *log.Logger
+
+	applied *setting
+	// Values of the SOC limits before they were changed, to put back
+	saved map[string]float64
+}
+
+// setting is what's been written to the inverter
+type setting struct {
+	mode    int
+	command int
+	power   float64
+	window  string
+	// SOC limit register holding the window's target, so the inverter
+	// stops at it between steps
+	limit string
+	soc   float64
+}
+
+// NewScheduler allocates a new Scheduler.
+func NewScheduler(inv *sungrow.Inverter, client sungrow.ModbusWriter, s *Schedule) *Scheduler {
+	return &Scheduler{
+		Inverter: inv,
+		Client:   client,
+		Schedule: s,
+		Interval: defaultInterval,
+	}
+}
+
+// Run steps every interval until the context is done or a write fails, the
+// default register values are restored either way.
+func (s *Scheduler) Run(ctx context.Context) error {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Step(time.Now()); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			s.logf("schedule: shutting down, restoring defaults")
+			return s.restore()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Step reads the SOC and writes whatever the schedule calls for at now,
+// restoring the defaults if a write fails.
+func (s *Scheduler) Step(now time.Time) error {
+	err := s.Inverter.ReadWithSkip(s.Client, func(r sungrow.Register, funcCode int) bool {
+		return !readRegisters[r.Name]
+	})
+	if err != nil {
+		// Nothing written yet, nothing to undo
+		if s.applied == nil {
+			return err
+		}
+
+		return s.fail(fmt.Errorf("read failed: %w", err))
+	}
+
+	want := setting{mode: sungrow.EMSModeSelfConsumption, command: sungrow.CommandStop}
+
+	if w := s.Schedule.Active(now); w != nil {
+		soc, isa := s.Inverter.Value("battery_level")
+		switch {
+		case !isa && w.TargetSOC > 0:
+			s.logf("schedule: no battery_level, skipping window %s", w.Name)
+		case w.Done(soc):
+			want.window = w.Name + " (target reached)"
+		default:
+			want = setting{mode: sungrow.EMSModeForced, command: sungrow.CommandCharge, power: w.Power, window: w.Name}
+			if w.Mode == ModeDischarge {
+				want.command = sungrow.CommandDischarge
+			}
+			if w.TargetSOC > 0 {
+				want.limit, want.soc = s.limit(w)
+			}
+		}
+	}
+
+	if s.applied != nil && s.applied.mode == want.mode && s.applied.command == want.command && s.applied.power == want.power &&
+		s.applied.limit == want.limit && s.applied.soc == want.soc {
+		return nil
+	}
+
+	if err := s.apply(want); err != nil {
+		return s.fail(err)
+	}
+
+	s.applied = &want
+
+	return nil
+}
+
+// limit picks the SOC limit register that stops the window at its target,
+// max_soc when charging and min_soc when discharging. Targets the register
+// can't hold are left to the battery_level checks of each step.
+func (s *Scheduler) limit(w *Window) (string, float64) {
+	name := "max_soc"
+	if w.Mode == ModeDischarge {
+		name = "min_soc"
+	}
+
+	r := s.Inverter.Holding(name)
+	if r == nil {
+		return "", 0
+	}
+
+	if _, err := r.Encode(w.TargetSOC); err != nil {
+		s.logf("schedule: window %s: %v, checking battery_level instead", w.Name, err)
+		return "", 0
+	}
+
+	return name, w.TargetSOC
+}
+
+func (s *Scheduler) apply(want setting) error {
+	if err := s.restoreLimits(want.limit); err != nil {
+		return err
+	}
+
+	if want.mode == sungrow.EMSModeForced {
+		s.logf("schedule: %s, forcing %s at %.0fW", want.window, commandName(want.command), want.power)
+
+		if want.limit != "" {
+			if err := s.setLimit(want.limit, want.soc); err != nil {
+				return err
+			}
+		}
+
+		if err := s.Inverter.Write(s.Client, "charge_discharge_power", want.power); err != nil {
+			return err
+		}
+
+		if err := s.Inverter.Write(s.Client, "charge_discharge_command", want.command); err != nil {
+			return err
+		}
+
+		return s.Inverter.Write(s.Client, "ems_mode_selection", want.mode)
+	}
+
+	if want.window != "" {
+		s.logf("schedule: %s, back to self-consumption", want.window)
+	} else {
+		s.logf("schedule: no window, self-consumption")
+	}
+
+	if err := s.Inverter.Write(s.Client, "ems_mode_selection", want.mode); err != nil {
+		return err
+	}
+
+	return s.Inverter.Write(s.Client, "charge_discharge_command", want.command)
+}
+
+func (s *Scheduler) fail(err error) error {
+	s.logf("schedule: %v, restoring defaults", err)
+	if rerr := s.restore(); rerr != nil {
+		return fmt.Errorf("%w, and failed to restore defaults: %v", err, rerr)
+	}
+	return err
+}
+
+// setLimit writes the SOC limit, saving what it was the first time
+func (s *Scheduler) setLimit(name string, soc float64) error {
+	if _, isa := s.saved[name]; !isa {
+		v, isa := s.Inverter.Value("holding." + name)
+		if !isa {
+			return fmt.Errorf("can't read %s to put it back later", name)
+		}
+
+		if s.saved == nil {
+			s.saved = map[string]float64{}
+		}
+		s.saved[name] = v
+	}
+
+	s.logf("schedule: setting %s to %.0f%%", name, soc)
+	return s.Inverter.Write(s.Client, name, soc)
+}
+
+// restoreLimits puts back the SOC limits changed, other than keep
+func (s *Scheduler) restoreLimits(keep string) error {
+	for name, v := range s.saved {
+		if name == keep {
+			continue
+		}
+
+		s.logf("schedule: putting %s back to %.0f%%", name, v)
+		if err := s.Inverter.Write(s.Client, name, v); err != nil {
+			return err
+		}
+		delete(s.saved, name)
+	}
+
+	return nil
+}
+
+func (s *Scheduler) restore() error {
+	s.applied = nil
+	err := s.Inverter.RestoreDefaults(s.Client)
+	if lerr := s.restoreLimits(""); err == nil {
+		err = lerr
+	}
+	return err
+}
+
+func (s *Scheduler) logf(format string, v ...interface{}) {
+	if s.Logger != nil {
+		s.Logger.Printf(format, v...)
+	}
+}
+
+func commandName(command int) string {
+	if command == sungrow.CommandDischarge {
+		return "discharge"
+	}
+	return "charge"
+}
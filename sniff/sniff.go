@@ -0,0 +1,259 @@
+// Package sniff relays a Modbus TCP client to the inverter byte for byte,
+// logging every exchange described with the register definition.
+package sniff
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/freman/sungrow/capture"
+)
+
+const (
+	defaultHistory     = 500
+	defaultDialTimeout = 10 * time.Second
+	bufferSize         = 4096
+)
+
+// Sniffer sits between a Modbus client and the inverter.
+type Sniffer struct {
+	// Inverter address, eg 192.168.1.20:502
+	Upstream string
+	// Names the registers in the exchanges
+	Annotator *capture.Annotator
+	// Every exchange is written here as a JSON line
+	Log io.Writer
+	// Exchanges kept for the web view
+	History int
+	// Connection logger
+	Logger *log.Logger
+
+	mu       sync.Mutex
+	decoder  *capture.Decoder
+	recent   []capture.Annotated
+	watchers map[chan capture.Annotated]struct{}
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+}
+
+// New allocates a Sniffer relaying to upstream.
+func New(upstream string, annotator *capture.Annotator) *Sniffer {
+	return &Sniffer{
+		Upstream:  upstream,
+		Annotator: annotator,
+		History:   defaultHistory,
+	}
+}
+
+// ListenAndServe listens on the TCP address and relays clients.
+func (s *Sniffer) ListenAndServe(address string) error {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(l)
+}
+
+// Serve accepts connections on the listener until it is closed.
+func (s *Sniffer) Serve(l net.Listener) error {
+	s.mu.Lock()
+	s.listener = l
+	s.init()
+	s.mu.Unlock()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		go s.relay(conn)
+	}
+}
+
+// Close stops accepting connections and closes the relayed ones.
+func (s *Sniffer) Close() (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener != nil {
+		err = s.listener.Close()
+	}
+
+	for conn := range s.conns {
+		conn.Close()
+		delete(s.conns, conn)
+	}
+
+	return
+}
+
+// init must be called with the lock held
+func (s *Sniffer) init() {
+	if s.decoder == nil {
+		s.decoder = capture.NewDecoder()
+		s.conns = map[net.Conn]struct{}{}
+		s.watchers = map[chan capture.Annotated]struct{}{}
+	}
+}
+
+func (s *Sniffer) track(conns ...net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range conns {
+		s.conns[c] = struct{}{}
+	}
+}
+
+func (s *Sniffer) untrack(conns ...net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range conns {
+		c.Close()
+		delete(s.conns, c)
+	}
+}
+
+func (s *Sniffer) relay(downstream net.Conn) {
+	upstream, err := net.DialTimeout("tcp", s.Upstream, defaultDialTimeout)
+	if err != nil {
+		s.logf("sniff: client %v: %v", downstream.RemoteAddr(), err)
+		downstream.Close()
+		return
+	}
+
+	s.track(downstream, upstream)
+	defer s.untrack(downstream, upstream)
+
+	client := capture.EndpointOf(downstream.RemoteAddr())
+	server := capture.EndpointOf(upstream.RemoteAddr())
+
+	s.logf("sniff: client %v connected, relaying to %v", client, server)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		s.copy(upstream, downstream, client, server, true)
+		// Let the other direction finish up
+		if tcp, isa := upstream.(*net.TCPConn); isa {
+			tcp.CloseWrite()
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		s.copy(downstream, upstream, client, server, false)
+		if tcp, isa := downstream.(*net.TCPConn); isa {
+			tcp.CloseWrite()
+		}
+	}()
+
+	wg.Wait()
+
+	s.mu.Lock()
+	exchanges := s.decoder.Close(client, server)
+	s.mu.Unlock()
+	s.observe(exchanges)
+
+	s.logf("sniff: client %v disconnected", client)
+}
+
+// copy forwards everything unchanged, decoding it once it's gone.
+func (s *Sniffer) copy(dst io.Writer, src io.Reader, client, server capture.Endpoint, fromClient bool) {
+	buf := make([]byte, bufferSize)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			if _, werr := dst.Write(buf[:n]); werr != nil {
+				return
+			}
+
+			s.mu.Lock()
+			exchanges := s.decoder.Feed(client, server, fromClient, time.Now(), buf[:n])
+			s.mu.Unlock()
+			s.observe(exchanges)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *Sniffer) observe(exchanges []capture.Exchange) {
+	if len(exchanges) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range exchanges {
+		an := s.Annotator.Annotate(e)
+
+		if s.Log != nil {
+			if b, err := json.Marshal(an); err == nil {
+				s.Log.Write(append(b, '\n'))
+			}
+		}
+
+		s.recent = append(s.recent, an)
+		if s.History > 0 && len(s.recent) > s.History {
+			s.recent = s.recent[len(s.recent)-s.History:]
+		}
+
+		for w := range s.watchers {
+			select {
+			case w <- an:
+			default:
+				// Too slow to keep up, it'll catch up from Recent
+			}
+		}
+	}
+}
+
+// Recent returns the exchanges kept for the web view, oldest first.
+func (s *Sniffer) Recent() []capture.Annotated {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]capture.Annotated(nil), s.recent...)
+}
+
+// Watch returns a channel of exchanges as they happen, stop with Unwatch.
+func (s *Sniffer) Watch() chan capture.Annotated {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.init()
+	w := make(chan capture.Annotated, 64)
+	s.watchers[w] = struct{}{}
+	return w
+}
+
+// Unwatch stops the exchanges on a channel from Watch.
+func (s *Sniffer) Unwatch(w chan capture.Annotated) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.watchers, w)
+}
+
+func (s *Sniffer) logf(format string, v ...interface{}) {
+	if s.Logger != nil {
+		s.Logger.Printf(format, v...)
+	}
+}
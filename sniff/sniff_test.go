@@ -0,0 +1,160 @@
+package sniff_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/capture"
+	"github.com/freman/sungrow/sniff"
+	"github.com/goburrow/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+const registers = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0x147: "SG5KTL-MT"
+    - address: 5001
+      name: "nominal_active_power"
+      unit: "kW"
+      scale: 0.1
+  holding:
+    - address: 13000
+      name: "ems_mode_selection"
+      values:
+        0: "self consumption"
+        2: "forced"
+`
+
+// inverter answers Modbus TCP like an SG5KTL-MT
+func inverter(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				for {
+					req := make([]byte, 12)
+					if _, err := conn.Read(req); err != nil {
+						return
+					}
+
+					resp := append([]byte(nil), req[:7]...)
+					switch req[7] {
+					case modbus.FuncCodeReadInputRegisters:
+						resp = append(resp, 0x04, 0x04, 0x01, 0x47, 0x00, 0x32)
+					default:
+						resp = append(resp, req[7:12]...)
+					}
+					binary.BigEndian.PutUint16(resp[4:], uint16(len(resp)-6))
+					conn.Write(resp)
+				}
+			}()
+		}
+	}()
+
+	return l.Addr().String()
+}
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestSniffer(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(registers)))
+
+	var logged syncBuffer
+	s := sniff.New(inverter(t), capture.NewAnnotator(&inv))
+	s.Log = &logged
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	requires.NoError(err)
+	go s.Serve(l)
+	defer s.Close()
+
+	watch := s.Watch()
+	defer s.Unwatch(watch)
+
+	handler := modbus.NewTCPClientHandler(l.Addr().String())
+	handler.SlaveId = 1
+	defer handler.Close()
+	client := modbus.NewClient(handler)
+
+	// The answer comes through untouched
+	results, err := client.ReadInputRegisters(4999, 2)
+	requires.NoError(err)
+	requires.Equal([]byte{0x01, 0x47, 0x00, 0x32}, results)
+
+	_, err = client.WriteSingleRegister(12999, 2)
+	requires.NoError(err)
+
+	var seen []capture.Annotated
+	for len(seen) < 2 {
+		select {
+		case an := <-watch:
+			seen = append(seen, an)
+		case <-time.After(time.Second):
+			t.Fatal("no exchange seen")
+		}
+	}
+
+	requires.Equal("SG5KTL-MT", seen[0].Model)
+	requires.Equal([]capture.Value{
+		{Address: 5000, Name: "device_type_code", Value: "SG5KTL-MT"},
+		{Address: 5001, Name: "nominal_active_power", Value: 5.0, Unit: "kW"},
+	}, seen[0].Registers)
+	requires.Equal([]capture.Value{{Address: 13000, Name: "ems_mode_selection", Value: "forced"}}, seen[1].Registers)
+
+	requires.Eventually(func() bool { return strings.Count(logged.String(), "\n") == 2 }, time.Second, 5*time.Millisecond)
+
+	scanner := bufio.NewScanner(strings.NewReader(logged.String()))
+	requires.True(scanner.Scan())
+
+	var first capture.Annotated
+	requires.NoError(json.Unmarshal(scanner.Bytes(), &first))
+	requires.Equal("read input", first.Function)
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest("GET", "/exchanges", nil))
+	requires.Equal(200, rec.Code)
+
+	var recent []capture.Annotated
+	requires.NoError(json.NewDecoder(rec.Body).Decode(&recent))
+	requires.Len(recent, 2)
+	requires.Equal("write holding", recent[1].Function)
+}
@@ -0,0 +1,112 @@
+package sniff
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ServeHTTP is the live web view
+//
+//	/            page following the exchanges as they happen
+//	/exchanges   the recent exchanges as JSON
+//	/events      server sent events, one per exchange
+func (s *Sniffer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/":
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, page)
+	case "/exchanges":
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(s.Recent())
+	case "/events":
+		s.serveEvents(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Sniffer) serveEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, isa := w.(http.Flusher)
+	if !isa {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	watch := s.Watch()
+	defer s.Unwatch(watch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case an := <-watch:
+			b, err := json.Marshal(an)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "data: %s\n\n", b)
+			flusher.Flush()
+		}
+	}
+}
+
+const page = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Modbus sniffer</title>
+<style>
+body { font-family: sans-serif; font-size: 13px; margin: 1em; }
+table { border-collapse: collapse; width: 100%; }
+th, td { text-align: left; padding: 2px 8px; border-bottom: 1px solid #ddd; vertical-align: top; }
+.write { background: #fff4e0; }
+.exception { color: #b00; }
+.registers { font-family: monospace; }
+</style>
+</head>
+<body>
+<table>
+<thead><tr><th>Time</th><th>Client</th><th>Function</th><th>Address</th><th>Result</th><th>Registers</th></tr></thead>
+<tbody id="exchanges"></tbody>
+</table>
+<script>
+const body = document.getElementById("exchanges");
+
+function show(e) {
+	const row = body.insertRow(0);
+	if (e.function.startsWith("write")) row.className = "write";
+	row.insertCell().textContent = new Date(e.time).toLocaleTimeString();
+	row.insertCell().textContent = e.client;
+	row.insertCell().textContent = e.function;
+	row.insertCell().textContent = e.quantity ? e.address + " x" + e.quantity : "";
+	const result = row.insertCell();
+	if (e.exception) {
+		result.textContent = e.exception;
+		result.className = "exception";
+	} else {
+		result.textContent = e.response ? (e.latency / 1e6).toFixed(1) + "ms" : "no response";
+	}
+	if (e.quirks) result.textContent += " [" + e.quirks.join(", ") + "]";
+	const regs = row.insertCell();
+	regs.className = "registers";
+	regs.textContent = (e.registers || []).map(r =>
+		r.address + " " + r.name + (r.value !== undefined ? " = " + JSON.stringify(r.value) + (r.unit ? " " + r.unit : "") : "")
+	).join("\n");
+	regs.style.whiteSpace = "pre";
+	while (body.rows.length > 1000) body.deleteRow(-1);
+}
+
+fetch("exchanges").then(r => r.json()).then(list => {
+	(list || []).forEach(show);
+	new EventSource("events").onmessage = m => show(JSON.parse(m.data));
+});
+</script>
+</body>
+</html>
+`
@@ -51,6 +51,11 @@ type borkedTCPPackager struct {
 	SlaveID byte
 }
 
+// SetSlaveID changes the unit ID of the requests encoded from now on.
+func (mb *borkedTCPPackager) SetSlaveID(id byte) {
+	mb.SlaveID = id
+}
+
 // Encode adds modbus application protocol header:
 //  Transaction identifier: 2 bytes
 //  Protocol identifier: 2 bytes
@@ -142,6 +147,13 @@ type borkedTCPTransport struct {
 
 // Send sends data to server and ensures response length is greater than header length.
 func (mb *borkedTCPTransport) Send(aduRequest []byte) (aduResponse []byte, err error) {
+	aduResponse, _, err = mb.sendRaw(aduRequest)
+	return
+}
+
+// sendRaw is Send that also returns the response as it was read, before
+// any repair.
+func (mb *borkedTCPTransport) sendRaw(aduRequest []byte) (aduResponse, raw []byte, err error) {
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
 
@@ -172,7 +184,6 @@ func (mb *borkedTCPTransport) Send(aduRequest []byte) (aduResponse []byte, err e
 	}
 	// Read length, ignore transaction & protocol id (4 bytes)
 	length := int(binary.BigEndian.Uint16(data[4:]))
-	svLen := length
 	if length <= 0 {
 		mb.flush(data[:])
 		err = fmt.Errorf("modbus: length in response header '%v' must not be zero", length)
@@ -190,16 +201,21 @@ func (mb *borkedTCPTransport) Send(aduRequest []byte) (aduResponse []byte, err e
 	}
 
 	// Handle the malformed exception unique to Sungrow inverters using the winet-s v12
-	if data[7] == 0x84 && svLen == 2 {
+	if IsShortException(data[:tcpHeaderSize], data[7]) {
 		if _, err = io.ReadFull(mb.conn, data[length:length+1]); err != nil {
 			return
 		}
 
+		raw = append([]byte(nil), data[:length+1]...)
+
 		length += 1
 		data[5]++
 	}
 
 	aduResponse = data[:length]
+	if raw == nil {
+		raw = aduResponse
+	}
 
 	mb.logf("modbus: received % x\n", aduResponse)
 	return
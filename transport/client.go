@@ -0,0 +1,216 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+
+	"github.com/goburrow/modbus"
+)
+
+const defaultFailureThreshold = 3
+
+// Backend is a named handler a Client can use.
+type Backend struct {
+	Name    string
+	Handler modbus.ClientHandler
+}
+
+// Client implements modbus.Client over one or more backends, moving on to
+// the next backend when the active one keeps failing.
+type Client struct {
+	// Consecutive failures before falling back to the next backend
+	Threshold int
+	// Transmission logger
+	Logger *log.Logger
+
+	mu       sync.Mutex
+	backends []Backend
+	clients  []modbus.Client
+	active   int
+	failures int
+	last     string
+}
+
+// NewClient allocates a new Client, backends are tried in order.
+func NewClient(backends ...Backend) *Client {
+	c := &Client{
+		Threshold: defaultFailureThreshold,
+		backends:  backends,
+	}
+
+	for _, b := range backends {
+		c.clients = append(c.clients, modbus.NewClient(b.Handler))
+	}
+
+	return c
+}
+
+// LogTransmissions logs every request and response of every backend to l,
+// along with the fallbacks between them.
+func (c *Client) LogTransmissions(l *log.Logger) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Logger = l
+	for i, b := range c.backends {
+		h := &loggingHandler{ClientHandler: b.Handler, name: b.Name, logger: l}
+		c.backends[i].Handler = h
+		c.clients[i] = modbus.NewClient(h)
+	}
+}
+
+// Transport returns the name of the backend that served the last request.
+func (c *Client) Transport() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.last
+}
+
+// Backends returns the names of the available backends.
+func (c *Client) Backends() []string {
+	names := make([]string, len(c.backends))
+	for i, b := range c.backends {
+		names[i] = b.Name
+	}
+	return names
+}
+
+// Close closes every backend.
+func (c *Client) Close() (err error) {
+	for _, b := range c.backends {
+		if closer, isa := b.Handler.(io.Closer); isa {
+			if cerr := closer.Close(); cerr != nil {
+				err = cerr
+			}
+		}
+	}
+	return
+}
+
+func (c *Client) do(fn func(modbus.Client) ([]byte, error)) (results []byte, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.clients) == 0 {
+		return nil, errors.New("no transports available")
+	}
+
+	idx := c.active
+	for tries := 0; tries < len(c.clients); tries++ {
+		results, err = fn(c.clients[idx])
+		c.last = c.backends[idx].Name
+
+		// The device answering with an exception is still a device answering
+		var mbErr *modbus.ModbusError
+		if err == nil || errors.As(err, &mbErr) {
+			if idx == c.active {
+				c.failures = 0
+			}
+			return
+		}
+
+		// Not a failure of the transport, just something it can't do
+		if errors.Is(err, ErrNotSupported) {
+			idx = (idx + 1) % len(c.clients)
+			continue
+		}
+
+		if idx != c.active {
+			return
+		}
+
+		c.failures++
+		if c.failures < c.Threshold || len(c.clients) == 1 {
+			return
+		}
+
+		c.logf("modbus: %s failed %d times, falling back: %v", c.last, c.failures, err)
+		c.failures = 0
+		c.active = (c.active + 1) % len(c.clients)
+		idx = c.active
+	}
+
+	return nil, fmt.Errorf("all transports failed: %w", err)
+}
+
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.Logger != nil {
+		c.Logger.Printf(format, v...)
+	}
+}
+
+func (c *Client) ReadCoils(address, quantity uint16) ([]byte, error) {
+	return c.do(func(m modbus.Client) ([]byte, error) { return m.ReadCoils(address, quantity) })
+}
+
+func (c *Client) ReadDiscreteInputs(address, quantity uint16) ([]byte, error) {
+	return c.do(func(m modbus.Client) ([]byte, error) { return m.ReadDiscreteInputs(address, quantity) })
+}
+
+func (c *Client) WriteSingleCoil(address, value uint16) ([]byte, error) {
+	return c.do(func(m modbus.Client) ([]byte, error) { return m.WriteSingleCoil(address, value) })
+}
+
+func (c *Client) WriteMultipleCoils(address, quantity uint16, value []byte) ([]byte, error) {
+	return c.do(func(m modbus.Client) ([]byte, error) { return m.WriteMultipleCoils(address, quantity, value) })
+}
+
+func (c *Client) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	return c.do(func(m modbus.Client) ([]byte, error) { return m.ReadInputRegisters(address, quantity) })
+}
+
+func (c *Client) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return c.do(func(m modbus.Client) ([]byte, error) { return m.ReadHoldingRegisters(address, quantity) })
+}
+
+func (c *Client) WriteSingleRegister(address, value uint16) ([]byte, error) {
+	return c.do(func(m modbus.Client) ([]byte, error) { return m.WriteSingleRegister(address, value) })
+}
+
+func (c *Client) WriteMultipleRegisters(address, quantity uint16, value []byte) ([]byte, error) {
+	return c.do(func(m modbus.Client) ([]byte, error) { return m.WriteMultipleRegisters(address, quantity, value) })
+}
+
+func (c *Client) ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity uint16, value []byte) ([]byte, error) {
+	return c.do(func(m modbus.Client) ([]byte, error) {
+		return m.ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity, value)
+	})
+}
+
+func (c *Client) MaskWriteRegister(address, andMask, orMask uint16) ([]byte, error) {
+	return c.do(func(m modbus.Client) ([]byte, error) { return m.MaskWriteRegister(address, andMask, orMask) })
+}
+
+func (c *Client) ReadFIFOQueue(address uint16) ([]byte, error) {
+	return c.do(func(m modbus.Client) ([]byte, error) { return m.ReadFIFOQueue(address) })
+}
+
+type loggingHandler struct {
+	modbus.ClientHandler
+	name   string
+	logger *log.Logger
+}
+
+func (h *loggingHandler) Send(aduRequest []byte) ([]byte, error) {
+	h.logger.Printf("modbus: %s sending % x", h.name, aduRequest)
+
+	aduResponse, err := h.ClientHandler.Send(aduRequest)
+	if err != nil {
+		h.logger.Printf("modbus: %s failed: %v", h.name, err)
+		return aduResponse, err
+	}
+
+	h.logger.Printf("modbus: %s received % x", h.name, aduResponse)
+	return aduResponse, nil
+}
+
+func (h *loggingHandler) Close() error {
+	if closer, isa := h.ClientHandler.(io.Closer); isa {
+		return closer.Close()
+	}
+	return nil
+}
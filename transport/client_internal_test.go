@@ -0,0 +1,176 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"testing"
+
+	"github.com/goburrow/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHandler struct {
+	err   error
+	calls int
+}
+
+func (f *fakeHandler) Encode(pdu *modbus.ProtocolDataUnit) ([]byte, error) {
+	if f.err == ErrNotSupported && pdu.FunctionCode != modbus.FuncCodeReadInputRegisters {
+		return nil, f.err
+	}
+	return append([]byte{pdu.FunctionCode}, pdu.Data...), nil
+}
+
+func (f *fakeHandler) Verify(aduRequest, aduResponse []byte) error {
+	return nil
+}
+
+func (f *fakeHandler) Decode(adu []byte) (*modbus.ProtocolDataUnit, error) {
+	return &modbus.ProtocolDataUnit{FunctionCode: adu[0], Data: adu[1:]}, nil
+}
+
+func (f *fakeHandler) Send(aduRequest []byte) ([]byte, error) {
+	f.calls++
+	if f.err != nil && f.err != ErrNotSupported {
+		return nil, f.err
+	}
+	if aduRequest[0] == modbus.FuncCodeReadInputRegisters {
+		return []byte{aduRequest[0], 2, 0, 42}, nil
+	}
+	return aduRequest, nil
+}
+
+func TestClientFallback(t *testing.T) {
+	requires := require.New(t)
+
+	broken := &fakeHandler{err: errors.New("connection refused")}
+	working := &fakeHandler{}
+
+	client := NewClient(Backend{"tcp", broken}, Backend{"winet", working})
+	client.Threshold = 2
+
+	_, err := client.ReadInputRegisters(4999, 1)
+	requires.Error(err)
+	requires.Equal("tcp", client.Transport())
+
+	// Second failure crosses the threshold and the request is retried on the next backend
+	results, err := client.ReadInputRegisters(4999, 1)
+	requires.NoError(err)
+	requires.Equal([]byte{0, 42}, results)
+	requires.Equal("winet", client.Transport())
+	requires.Equal(2, broken.calls)
+
+	// And it sticks
+	_, err = client.ReadInputRegisters(4999, 1)
+	requires.NoError(err)
+	requires.Equal("winet", client.Transport())
+	requires.Equal(2, broken.calls)
+	requires.Equal(2, working.calls)
+}
+
+func TestClientNotSupported(t *testing.T) {
+	requires := require.New(t)
+
+	readOnly := &fakeHandler{err: ErrNotSupported}
+	working := &fakeHandler{}
+
+	client := NewClient(Backend{"winet", readOnly}, Backend{"tcp", working})
+
+	_, err := client.WriteSingleRegister(13049, 2)
+	requires.NoError(err)
+	requires.Equal("tcp", client.Transport())
+
+	// Still preferring the first backend
+	client.ReadInputRegisters(4999, 1)
+	requires.Equal("winet", client.Transport())
+}
+
+func TestClientLogTransmissions(t *testing.T) {
+	requires := require.New(t)
+
+	var buf bytes.Buffer
+	client := NewClient(Backend{"tcp", &fakeHandler{}})
+	client.LogTransmissions(log.New(&buf, "", 0))
+
+	_, err := client.ReadInputRegisters(4999, 1)
+	requires.NoError(err)
+	requires.Equal("modbus: tcp sending 04 13 87 00 01\nmodbus: tcp received 04 02 00 2a\n", buf.String())
+}
+
+func TestDial(t *testing.T) {
+	requires := require.New(t)
+
+	client, err := Dial("tcp://10.0.0.84?slave=2")
+	requires.NoError(err)
+	requires.Equal([]string{"tcp"}, client.Backends())
+	tcp := client.backends[0].Handler.(*BorkedTCPHandler)
+	requires.Equal("10.0.0.84:502", tcp.Address)
+	requires.EqualValues(2, tcp.SlaveID)
+
+	client, err = Dial("winet://10.0.0.84:9000?http=8080&timeout=2s")
+	requires.NoError(err)
+	winet := client.backends[0].Handler.(*HTTPClientHandler)
+	requires.Equal("10.0.0.84", winet.Host)
+	requires.Equal(9000, winet.WSPort)
+	requires.Equal(8080, winet.HTTPPort)
+	requires.Equal("2s", winet.Timeout.String())
+
+	client, err = Dial("rtu:///dev/ttyUSB0?baud=19200")
+	requires.NoError(err)
+	rtu := client.backends[0].Handler.(*modbus.RTUClientHandler)
+	requires.Equal("/dev/ttyUSB0", rtu.Address)
+	requires.Equal(19200, rtu.BaudRate)
+
+	_, err = Dial("carrier-pigeon://10.0.0.84")
+	requires.Error(err)
+}
+
+func TestDialAuto(t *testing.T) {
+	requires := require.New(t)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	requires.NoError(err)
+	defer l.Close()
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			req := make([]byte, 12)
+			if _, err := io.ReadFull(conn, req); err != nil {
+				return
+			}
+			resp := append([]byte(nil), req[:8]...)
+			binary.BigEndian.PutUint16(resp[4:], 5)
+			conn.Write(append(resp, 2, 0x0E, 0x03))
+		}
+	}()
+
+	// Find a port nothing is listening on for the websocket
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	requires.NoError(err)
+	closed.Close()
+
+	_, tcpPort, _ := net.SplitHostPort(l.Addr().String())
+	_, wsPort, _ := net.SplitHostPort(closed.Addr().String())
+
+	client, err := Dial("auto://127.0.0.1?tcp=" + tcpPort + "&ws=" + wsPort + "&timeout=1s")
+	requires.NoError(err)
+	defer client.Close()
+	requires.Equal([]string{"tcp"}, client.Backends())
+
+	results, err := client.ReadInputRegisters(4999, 1)
+	requires.NoError(err)
+	requires.Equal([]byte{0x0E, 0x03}, results)
+
+	_, err = Dial("auto://127.0.0.1?tcp=" + wsPort + "&ws=" + wsPort + "&timeout=1s")
+	requires.Error(err)
+}
@@ -0,0 +1,306 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/goburrow/modbus"
+)
+
+const (
+	tcpPort = 502
+
+	// device_type_code, every Sungrow inverter has one
+	probeAddress = 4999
+)
+
+// Dial opens a client described by the connection string, one of
+//
+//	tcp://host[:502]
+//	winet://host[:8082]?http=80
+//	rtu:///dev/ttyUSB0?baud=9600&parity=N&data=8&stop=1
+//	auto://host?tcp=502&ws=8082&http=80
+//	replay:///path/to/recording.jsonl?realtime=true
+//
+// Every scheme accepts slave=1 and timeout=10s, auto probes tcp and winet
+// and falls back between those that answer.
+//
+// Every exchange is appended to a recording with record=/path/to/recording.jsonl
+// for replaying later.
+//
+// Requests are put through a LimitedHandler when any of these are given
+//
+//	rate=2         requests per second
+//	burst=1        requests back to back after a quiet spell
+//	deadline=15s   longest a request can wait and be sent for
+//	breaker=5      consecutive failures before leaving the device alone
+//	cooldown=10s   how long the device is first left alone for
+func Dial(uri string) (*Client, error) {
+	client, err := dial(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	// Already parsed once by dial
+	u, _ := url.Parse(uri)
+	query := u.Query()
+
+	var recorder *Recorder
+	if name := query.Get("record"); name != "" && u.Scheme != "replay" {
+		if recorder, err = CreateRecorder(name); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, b := range client.backends {
+		h := b.Handler
+		if recorder != nil {
+			h = recorder.Wrap(b.Name, h)
+		}
+
+		if h, err = limit(h, query); err != nil {
+			return nil, err
+		}
+
+		client.backends[i].Handler = h
+		client.clients[i] = modbus.NewClient(h)
+	}
+
+	return client, nil
+}
+
+func dial(uri string) (*Client, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	query := u.Query()
+
+	slaveID := 1
+	if s := query.Get("slave"); s != "" {
+		if slaveID, err = strconv.Atoi(s); err != nil {
+			return nil, fmt.Errorf("invalid slave %q: %w", s, err)
+		}
+	}
+
+	timeout := time.Duration(0)
+	if s := query.Get("timeout"); s != "" {
+		if timeout, err = time.ParseDuration(s); err != nil {
+			return nil, fmt.Errorf("invalid timeout %q: %w", s, err)
+		}
+	}
+
+	switch u.Scheme {
+	case "tcp":
+		return NewClient(tcpBackend(u, byte(slaveID), timeout)), nil
+	case "winet", "http":
+		b, err := winetBackend(u, byte(slaveID), timeout)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(b), nil
+	case "rtu":
+		b, err := rtuBackend(u, byte(slaveID), timeout)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(b), nil
+	case "auto":
+		return autoClient(u, byte(slaveID), timeout)
+	case "replay":
+		return replayClient(u)
+	}
+
+	return nil, fmt.Errorf("unknown transport %q", u.Scheme)
+}
+
+// limit wraps h in a LimitedHandler configured from the query, h is returned
+// as is when the query doesn't mention any limits.
+func limit(h modbus.ClientHandler, query url.Values) (modbus.ClientHandler, error) {
+	wrapped := false
+	l := NewLimitedHandler(h)
+
+	for k, dst := range map[string]*int{"burst": &l.Burst, "breaker": &l.Threshold} {
+		if s := query.Get(k); s != "" {
+			v, err := strconv.Atoi(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %w", k, s, err)
+			}
+			*dst, wrapped = v, true
+		}
+	}
+
+	for k, dst := range map[string]*time.Duration{"deadline": &l.Timeout, "cooldown": &l.Cooldown} {
+		if s := query.Get(k); s != "" {
+			v, err := time.ParseDuration(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %w", k, s, err)
+			}
+			*dst, wrapped = v, true
+		}
+	}
+
+	if s := query.Get("rate"); s != "" {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate %q: %w", s, err)
+		}
+		l.Rate, wrapped = v, true
+	}
+
+	if !wrapped {
+		return h, nil
+	}
+
+	return l, nil
+}
+
+func tcpBackend(u *url.URL, slaveID byte, timeout time.Duration) Backend {
+	address := u.Host
+	if u.Port() == "" {
+		address = net.JoinHostPort(u.Hostname(), strconv.Itoa(tcpPort))
+	}
+
+	h := NewBorkedTCPClient(address)
+	h.SlaveID = slaveID
+	if timeout > 0 {
+		h.Timeout = timeout
+	}
+
+	return Backend{Name: "tcp", Handler: h}
+}
+
+func winetBackend(u *url.URL, slaveID byte, timeout time.Duration) (Backend, error) {
+	h := NewHTTPClientHandler(u.Hostname())
+	h.SlaveID = slaveID
+	if timeout > 0 {
+		h.Timeout = timeout
+	}
+
+	if p := u.Port(); p != "" {
+		port, err := strconv.Atoi(p)
+		if err != nil {
+			return Backend{}, fmt.Errorf("invalid websocket port %q: %w", p, err)
+		}
+		h.WSPort = port
+	}
+
+	if p := u.Query().Get("http"); p != "" {
+		port, err := strconv.Atoi(p)
+		if err != nil {
+			return Backend{}, fmt.Errorf("invalid http port %q: %w", p, err)
+		}
+		h.HTTPPort = port
+	}
+
+	return Backend{Name: "winet", Handler: h}, nil
+}
+
+func rtuBackend(u *url.URL, slaveID byte, timeout time.Duration) (Backend, error) {
+	query := u.Query()
+
+	h := modbus.NewRTUClientHandler(u.Path)
+	h.SlaveId = slaveID
+	h.BaudRate = 9600
+	h.DataBits = 8
+	h.Parity = "N"
+	h.StopBits = 1
+	if timeout > 0 {
+		h.Timeout = timeout
+	}
+
+	for k, dst := range map[string]*int{"baud": &h.BaudRate, "data": &h.DataBits, "stop": &h.StopBits} {
+		if s := query.Get(k); s != "" {
+			v, err := strconv.Atoi(s)
+			if err != nil {
+				return Backend{}, fmt.Errorf("invalid %s %q: %w", k, s, err)
+			}
+			*dst = v
+		}
+	}
+
+	if s := query.Get("parity"); s != "" {
+		h.Parity = s
+	}
+
+	return Backend{Name: "rtu", Handler: h}, nil
+}
+
+func replayClient(u *url.URL) (*Client, error) {
+	// replay://relative/path parses the first directory as the host
+	name := u.Host + u.Path
+	if u.Opaque != "" {
+		name = u.Opaque
+	}
+
+	client, err := ReplayFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if realtime, _ := strconv.ParseBool(u.Query().Get("realtime")); realtime {
+		for _, b := range client.backends {
+			b.Handler.(*ReplayHandler).Realtime = true
+		}
+	}
+
+	return client, nil
+}
+
+// autoClient probes tcp and winet, keeping those that answer with tcp preferred.
+func autoClient(u *url.URL, slaveID byte, timeout time.Duration) (*Client, error) {
+	query := u.Query()
+
+	tcpURL := &url.URL{Host: u.Hostname()}
+	if p := query.Get("tcp"); p != "" {
+		tcpURL.Host = net.JoinHostPort(u.Hostname(), p)
+	}
+
+	winetURL := &url.URL{Host: u.Hostname(), RawQuery: u.RawQuery}
+	if p := query.Get("ws"); p != "" {
+		winetURL.Host = net.JoinHostPort(u.Hostname(), p)
+	}
+
+	winet, err := winetBackend(winetURL, slaveID, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []Backend{
+		tcpBackend(tcpURL, slaveID, timeout),
+		winet,
+	}
+
+	var backends []Backend
+	var errs []error
+	for _, b := range candidates {
+		if err := Probe(b.Handler); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", b.Name, err))
+			continue
+		}
+		backends = append(backends, b)
+	}
+
+	if len(backends) == 0 {
+		return nil, fmt.Errorf("no transports answered: %v", errs)
+	}
+
+	return NewClient(backends...), nil
+}
+
+// Probe checks that something answers on the handler, an exception counts as an answer.
+func Probe(h modbus.ClientHandler) error {
+	_, err := modbus.NewClient(h).ReadInputRegisters(probeAddress, 1)
+
+	var mbErr *modbus.ModbusError
+	if err != nil && !errors.As(err, &mbErr) {
+		return err
+	}
+
+	return nil
+}
@@ -61,13 +61,18 @@ type httpPackager struct {
 	SlaveID byte
 }
 
+// SetSlaveID changes the unit ID of the requests encoded from now on.
+func (mb *httpPackager) SetSlaveID(id byte) {
+	mb.SlaveID = id
+}
+
 // Encode converts the PDU to binary
 func (mb *httpPackager) Encode(pdu *modbus.ProtocolDataUnit) (adu []byte, err error) {
 	ok := pdu.FunctionCode == modbus.FuncCodeReadInputRegisters
 	ok = ok || pdu.FunctionCode == modbus.FuncCodeReadHoldingRegisters
 
 	if !ok {
-		return adu, errors.New("not yet supported")
+		return adu, ErrNotSupported
 	}
 
 	paramType := paramTypeInputRegister
@@ -248,45 +253,16 @@ func (mb *httpTransporter) connect() error {
 			return fmt.Errorf("failure to dial websocket: %w", err)
 		}
 
-		if err != nil {
-			return fmt.Errorf("failed to connect to websocket to get token: %w", err)
-		}
-
 		defer c.Close()
 
-		if err := c.WriteMessage(websocket.TextMessage, []byte(websocketConnectMessage)); err != nil {
-			return fmt.Errorf("failed to send websocket connect message: %w", err)
-		}
-
-		var connectMessage jsonConnectMessage
-		var message jsonMessage
-		message.ResultData = &connectMessage
-
-		if err := c.ReadJSON(&message); err != nil {
-			return fmt.Errorf("failed to retrieve connect message from websocket: %w", err)
-		}
-
-		if message.ResultCode != 1 {
-			return fmt.Errorf("unexpected response from websocket: %s (%d)", message.ResultMsg, message.ResultCode)
-		}
-
-		mb.token = connectMessage.Token
-		if mb.token == "" {
-			return errors.New("failed to find token in connect message from websocket")
-		}
-
-		if err := c.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(websocketDeviceListMessageTemplate, mb.token))); err != nil {
-			return fmt.Errorf("failed to send device list request to websocket: %w", err)
-		}
-
-		var deviceListMessage jsonDeviceListMessage
-		message.ResultData = &deviceListMessage
-		if err := c.ReadJSON(&message); err != nil {
-			return fmt.Errorf("failed to retrieve device list from websocket: %w", err)
+		token, devices, err := websocketHandshake(c)
+		if err != nil {
+			return err
 		}
 
-		mb.devCode = deviceListMessage.List[0].DevCode
-		mb.devType = deviceListMessage.List[0].DevType
+		mb.token = token
+		mb.devCode = devices.List[0].DevCode
+		mb.devType = devices.List[0].DevType
 	}
 
 	return nil
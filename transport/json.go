@@ -18,24 +18,41 @@ type jsonConnectMessage struct {
 }
 
 type jsonDeviceListMessage struct {
+	Service string       `json:"service"`
+	List    []jsonDevice `json:"list"`
+	Count   int          `json:"count"`
+}
+
+type jsonDevice struct {
+	ID          int           `json:"id"`
+	DevID       int           `json:"dev_id"`
+	DevCode     int           `json:"dev_code"`
+	DevType     int           `json:"dev_type"`
+	DevProcotol int           `json:"dev_procotol"`
+	InvType     int           `json:"inv_type"`
+	DevSn       string        `json:"dev_sn"`
+	DevName     string        `json:"dev_name"`
+	DevModel    string        `json:"dev_model"`
+	PortName    string        `json:"port_name"`
+	PhysAddr    string        `json:"phys_addr"`
+	LogcAddr    string        `json:"logc_addr"`
+	LinkStatus  int           `json:"link_status"`
+	InitStatus  int           `json:"init_status"`
+	DevSpecial  string        `json:"dev_special"`
+	List        []interface{} `json:"list"`
+}
+
+type jsonRealtimeMessage struct {
 	Service string `json:"service"`
 	List    []struct {
-		ID          int           `json:"id"`
-		DevID       int           `json:"dev_id"`
-		DevCode     int           `json:"dev_code"`
-		DevType     int           `json:"dev_type"`
-		DevProcotol int           `json:"dev_procotol"`
-		InvType     int           `json:"inv_type"`
-		DevSn       string        `json:"dev_sn"`
-		DevName     string        `json:"dev_name"`
-		DevModel    string        `json:"dev_model"`
-		PortName    string        `json:"port_name"`
-		PhysAddr    string        `json:"phys_addr"`
-		LogcAddr    string        `json:"logc_addr"`
-		LinkStatus  int           `json:"link_status"`
-		InitStatus  int           `json:"init_status"`
-		DevSpecial  string        `json:"dev_special"`
-		List        []interface{} `json:"list"`
+		DataName    string `json:"data_name"`
+		DataValue   string `json:"data_value"`
+		DataUnit    string `json:"data_unit"`
+		Name        string `json:"name"`
+		Voltage     string `json:"voltage"`
+		VoltageUnit string `json:"voltage_unit"`
+		Current     string `json:"current"`
+		CurrentUnit string `json:"current_unit"`
 	} `json:"list"`
 	Count int `json:"count"`
 }
@@ -0,0 +1,322 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/goburrow/modbus"
+)
+
+const (
+	defaultBreakerThreshold = 5
+	defaultCooldown         = 10 * time.Second
+	defaultMaxCooldown      = 5 * time.Minute
+)
+
+var (
+	// ErrTimeout is returned when a request isn't answered within the limiter's timeout.
+	ErrTimeout = errors.New("request timed out")
+	// ErrCircuitOpen is returned without trying while a failing device is left alone.
+	ErrCircuitOpen = errors.New("circuit open, device is failing")
+)
+
+// Priority orders requests waiting for the device, higher goes first.
+type Priority int
+
+const (
+	// PriorityBulk is for polling
+	PriorityBulk Priority = iota
+	// PriorityInteractive is for writes, someone is waiting on them
+	PriorityInteractive
+)
+
+// LimitedHandler wraps a ClientHandler so that the device sees one request at
+// a time, no faster than the rate, in priority order, and is left alone for a
+// while after it keeps failing.
+type LimitedHandler struct {
+	modbus.ClientHandler
+
+	// Requests per second, unlimited when 0
+	Rate float64
+	// Requests that can be made back to back after a quiet spell
+	Burst int
+	// Longest a request can wait and be sent for, unlimited when 0
+	Timeout time.Duration
+	// Consecutive failures before the circuit opens, never when 0
+	Threshold int
+	// How long the circuit stays open, doubling each time the trial request
+	// fails up to MaxCooldown
+	Cooldown    time.Duration
+	MaxCooldown time.Duration
+	// Works out the priority of a request, writes are interactive by default
+	Classify func(aduRequest []byte) Priority
+	// Transmission logger
+	Logger *log.Logger
+
+	mu       sync.Mutex
+	queue    []*waiter
+	busy     bool
+	tokens   float64
+	refilled time.Time
+	timer    *time.Timer
+
+	failures int
+	trips    int
+	openedAt time.Time
+	cooldown time.Duration
+}
+
+type waiter struct {
+	priority Priority
+	ready    chan struct{}
+	granted  bool
+	err      error
+}
+
+// NewLimitedHandler allocates a new LimitedHandler around h.
+func NewLimitedHandler(h modbus.ClientHandler) *LimitedHandler {
+	return &LimitedHandler{
+		ClientHandler: h,
+		Burst:         1,
+		Threshold:     defaultBreakerThreshold,
+		Cooldown:      defaultCooldown,
+		MaxCooldown:   defaultMaxCooldown,
+	}
+}
+
+// Send waits for the device to be free, then sends the request.
+func (l *LimitedHandler) Send(aduRequest []byte) ([]byte, error) {
+	var deadline <-chan time.Time
+	if l.Timeout > 0 {
+		t := time.NewTimer(l.Timeout)
+		defer t.Stop()
+		deadline = t.C
+	}
+
+	w, err := l.enqueue(l.classify(aduRequest))
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-w.ready:
+		if w.err != nil {
+			return nil, w.err
+		}
+	case <-deadline:
+		if !l.abandon(w) && w.err == nil {
+			// Granted while timing out, the device has to be handed back
+			l.handBack()
+		}
+		return nil, fmt.Errorf("%w waiting for the device", ErrTimeout)
+	}
+
+	type result struct {
+		adu []byte
+		err error
+	}
+
+	// The device stays busy until it answers, even if the caller gives up
+	done := make(chan result, 1)
+	go func() {
+		adu, err := l.ClientHandler.Send(aduRequest)
+		l.release(err)
+		done <- result{adu, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.adu, r.err
+	case <-deadline:
+		return nil, ErrTimeout
+	}
+}
+
+// Close closes the wrapped handler.
+func (l *LimitedHandler) Close() error {
+	if closer, isa := l.ClientHandler.(io.Closer); isa {
+		return closer.Close()
+	}
+	return nil
+}
+
+func (l *LimitedHandler) classify(aduRequest []byte) Priority {
+	if l.Classify != nil {
+		return l.Classify(aduRequest)
+	}
+
+	pdu, err := l.ClientHandler.Decode(aduRequest)
+	if err != nil {
+		return PriorityBulk
+	}
+
+	switch pdu.FunctionCode {
+	case modbus.FuncCodeWriteSingleCoil, modbus.FuncCodeWriteMultipleCoils,
+		modbus.FuncCodeWriteSingleRegister, modbus.FuncCodeWriteMultipleRegisters,
+		modbus.FuncCodeReadWriteMultipleRegisters, modbus.FuncCodeMaskWriteRegister:
+		return PriorityInteractive
+	}
+
+	return PriorityBulk
+}
+
+func (l *LimitedHandler) enqueue(priority Priority) (*waiter, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.openedAt.IsZero() {
+		if time.Since(l.openedAt) < l.cooldown {
+			return nil, ErrCircuitOpen
+		}
+
+		// Half open, let one request through to see if the device is back
+		if l.busy || len(l.queue) > 0 {
+			return nil, ErrCircuitOpen
+		}
+	}
+
+	w := &waiter{priority: priority, ready: make(chan struct{})}
+	l.queue = append(l.queue, w)
+	sort.SliceStable(l.queue, func(i, j int) bool {
+		return l.queue[i].priority > l.queue[j].priority
+	})
+
+	l.dispatch()
+
+	return w, nil
+}
+
+// abandon removes a waiter that gave up, false if it was granted meanwhile.
+func (l *LimitedHandler) abandon(w *waiter) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if w.granted {
+		return false
+	}
+
+	for i, q := range l.queue {
+		if q == w {
+			l.queue = append(l.queue[:i], l.queue[i+1:]...)
+			break
+		}
+	}
+
+	return true
+}
+
+// handBack frees the device without it having been sent anything.
+func (l *LimitedHandler) handBack() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.busy = false
+	l.dispatch()
+}
+
+// release frees the device and records how the request went.
+func (l *LimitedHandler) release(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.busy = false
+
+	switch {
+	case err == nil:
+		if !l.openedAt.IsZero() {
+			l.logf("modbus: device answered, closing the circuit")
+		}
+		l.failures, l.trips = 0, 0
+		l.openedAt = time.Time{}
+	case l.Threshold > 0:
+		l.failures++
+		if !l.openedAt.IsZero() || l.failures >= l.Threshold {
+			l.trip(err)
+		}
+	}
+
+	l.dispatch()
+}
+
+func (l *LimitedHandler) trip(err error) {
+	l.cooldown = l.Cooldown
+	for i := 0; i < l.trips && l.cooldown < l.MaxCooldown; i++ {
+		l.cooldown *= 2
+	}
+	if l.MaxCooldown > 0 && l.cooldown > l.MaxCooldown {
+		l.cooldown = l.MaxCooldown
+	}
+
+	l.trips++
+	l.openedAt = time.Now()
+	l.logf("modbus: %d failures, leaving the device alone for %s: %v", l.failures, l.cooldown, err)
+
+	// Nobody waiting is going to get through
+	for _, w := range l.queue {
+		w.granted = true
+		w.err = ErrCircuitOpen
+		close(w.ready)
+	}
+	l.queue = nil
+}
+
+// dispatch hands the device to the first waiter once it's free and there's a
+// token, must be called with the lock held.
+func (l *LimitedHandler) dispatch() {
+	if l.busy || len(l.queue) == 0 {
+		return
+	}
+
+	if l.Rate > 0 {
+		l.refill()
+		if l.tokens < 1 {
+			if l.timer == nil {
+				wait := time.Duration((1 - l.tokens) / l.Rate * float64(time.Second))
+				l.timer = time.AfterFunc(wait, func() {
+					l.mu.Lock()
+					defer l.mu.Unlock()
+
+					l.timer = nil
+					l.dispatch()
+				})
+			}
+			return
+		}
+		l.tokens--
+	}
+
+	w := l.queue[0]
+	l.queue = l.queue[1:]
+	l.busy = true
+	w.granted = true
+	close(w.ready)
+}
+
+func (l *LimitedHandler) refill() {
+	burst := float64(l.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	now := time.Now()
+	if l.refilled.IsZero() {
+		l.tokens = burst
+	} else {
+		l.tokens += now.Sub(l.refilled).Seconds() * l.Rate
+		if l.tokens > burst {
+			l.tokens = burst
+		}
+	}
+	l.refilled = now
+}
+
+func (l *LimitedHandler) logf(format string, v ...interface{}) {
+	if l.Logger != nil {
+		l.Logger.Printf(format, v...)
+	}
+}
@@ -0,0 +1,189 @@
+package transport
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/goburrow/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+// gatedHandler records the order of requests, holding each until released
+type gatedHandler struct {
+	fakeHandler
+
+	mu    sync.Mutex
+	gate  chan struct{}
+	order []byte
+	fail  error
+}
+
+func (g *gatedHandler) Send(aduRequest []byte) ([]byte, error) {
+	if g.gate != nil {
+		<-g.gate
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.order = append(g.order, aduRequest[0])
+	if g.fail != nil {
+		return nil, g.fail
+	}
+	return g.fakeHandler.Send(aduRequest)
+}
+
+func (g *gatedHandler) sent() []byte {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return append([]byte(nil), g.order...)
+}
+
+func TestLimitedHandlerRate(t *testing.T) {
+	requires := require.New(t)
+
+	l := NewLimitedHandler(&gatedHandler{})
+	l.Rate = 20
+
+	client := modbus.NewClient(l)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := client.ReadInputRegisters(4999, 1)
+		requires.NoError(err)
+	}
+
+	// The first is free, the next two wait 50ms each
+	requires.GreaterOrEqual(time.Since(start), 90*time.Millisecond)
+}
+
+func TestLimitedHandlerPriority(t *testing.T) {
+	requires := require.New(t)
+
+	g := &gatedHandler{gate: make(chan struct{})}
+	l := NewLimitedHandler(g)
+	client := modbus.NewClient(l)
+
+	var wg sync.WaitGroup
+	do := func(fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			requires.NoError(fn())
+		}()
+	}
+
+	read := func() error { _, err := client.ReadInputRegisters(4999, 1); return err }
+	write := func() error { _, err := client.WriteSingleRegister(13049, 2); return err }
+
+	// Occupy the device then queue up polls ahead of a write
+	do(read)
+	requires.Eventually(func() bool { l.mu.Lock(); defer l.mu.Unlock(); return l.busy }, time.Second, time.Millisecond)
+
+	do(read)
+	do(read)
+	requires.Eventually(func() bool { l.mu.Lock(); defer l.mu.Unlock(); return len(l.queue) == 2 }, time.Second, time.Millisecond)
+
+	do(write)
+	requires.Eventually(func() bool { l.mu.Lock(); defer l.mu.Unlock(); return len(l.queue) == 3 }, time.Second, time.Millisecond)
+
+	close(g.gate)
+	wg.Wait()
+
+	requires.Equal([]byte{
+		modbus.FuncCodeReadInputRegisters,
+		modbus.FuncCodeWriteSingleRegister,
+		modbus.FuncCodeReadInputRegisters,
+		modbus.FuncCodeReadInputRegisters,
+	}, g.sent())
+}
+
+func TestLimitedHandlerTimeout(t *testing.T) {
+	requires := require.New(t)
+
+	g := &gatedHandler{gate: make(chan struct{})}
+	l := NewLimitedHandler(g)
+	l.Timeout = 20 * time.Millisecond
+
+	client := modbus.NewClient(l)
+
+	_, err := client.ReadInputRegisters(4999, 1)
+	requires.ErrorIs(err, ErrTimeout)
+
+	// Still waiting on the device for the first
+	_, err = client.ReadInputRegisters(4999, 1)
+	requires.ErrorIs(err, ErrTimeout)
+
+	close(g.gate)
+
+	requires.Eventually(func() bool {
+		_, err := client.ReadInputRegisters(4999, 1)
+		return err == nil
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestLimitedHandlerBreaker(t *testing.T) {
+	requires := require.New(t)
+
+	g := &gatedHandler{fail: errors.New("connection reset")}
+	l := NewLimitedHandler(g)
+	l.Threshold = 2
+	l.Cooldown = 30 * time.Millisecond
+
+	client := modbus.NewClient(l)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.ReadInputRegisters(4999, 1)
+		requires.EqualError(err, "connection reset")
+	}
+
+	// Left alone without trying
+	_, err := client.ReadInputRegisters(4999, 1)
+	requires.ErrorIs(err, ErrCircuitOpen)
+	requires.Len(g.sent(), 2)
+
+	// The trial fails and the cooldown doubles
+	time.Sleep(40 * time.Millisecond)
+	_, err = client.ReadInputRegisters(4999, 1)
+	requires.EqualError(err, "connection reset")
+	requires.Equal(60*time.Millisecond, l.cooldown)
+
+	time.Sleep(40 * time.Millisecond)
+	_, err = client.ReadInputRegisters(4999, 1)
+	requires.ErrorIs(err, ErrCircuitOpen)
+
+	g.mu.Lock()
+	g.fail = nil
+	g.mu.Unlock()
+
+	time.Sleep(30 * time.Millisecond)
+	_, err = client.ReadInputRegisters(4999, 1)
+	requires.NoError(err)
+
+	_, err = client.ReadInputRegisters(4999, 1)
+	requires.NoError(err)
+}
+
+func TestDialLimits(t *testing.T) {
+	requires := require.New(t)
+
+	client, err := Dial("tcp://192.0.2.1?rate=2&deadline=15s&breaker=3")
+	requires.NoError(err)
+
+	l, isa := client.backends[0].Handler.(*LimitedHandler)
+	requires.True(isa)
+	requires.Equal(2.0, l.Rate)
+	requires.Equal(15*time.Second, l.Timeout)
+	requires.Equal(3, l.Threshold)
+
+	client, err = Dial("tcp://192.0.2.1")
+	requires.NoError(err)
+	_, isa = client.backends[0].Handler.(*LimitedHandler)
+	requires.False(isa)
+
+	_, err = Dial("tcp://192.0.2.1?rate=fast")
+	requires.Error(err)
+}
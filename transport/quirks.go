@@ -0,0 +1,51 @@
+package transport
+
+import "errors"
+
+// ErrNotSupported is returned when a transport can't perform the requested function.
+var ErrNotSupported = errors.New("not yet supported")
+
+// Quirk describes a way Sungrow devices stray from the Modbus specification.
+type Quirk struct {
+	Name        string
+	Description string
+}
+
+// Known quirks, the transports in this package repair what they can and
+// proxy.Normalise takes care of the rest.
+var (
+	QuirkShortException = Quirk{
+		Name:        "short-exception",
+		Description: "WiNet-S v12 sends exception frames with a length of 2 in the MBAP header, followed by 3 bytes (unit id, function, exception code)",
+	}
+	QuirkExceptionFunction = Quirk{
+		Name:        "exception-function",
+		Description: "exceptions are raised as 0x84 no matter which function the request used",
+	}
+	QuirkEmptyException = Quirk{
+		Name:        "empty-exception",
+		Description: "exception responses without an exception code",
+	}
+	QuirkByteCount = Quirk{
+		Name:        "byte-count",
+		Description: "read responses where the byte count doesn't agree with the data returned or the quantity requested",
+	}
+	QuirkUnsupportedFunction = Quirk{
+		Name:        "unsupported-function",
+		Description: "the WiNet-S http interface can only read registers, everything else has to be refused as an illegal function",
+	}
+
+	Quirks = []Quirk{
+		QuirkShortException,
+		QuirkExceptionFunction,
+		QuirkEmptyException,
+		QuirkByteCount,
+		QuirkUnsupportedFunction,
+	}
+)
+
+// IsShortException reports whether the MBAP header and function code belong to
+// an exception frame that under reports its length by one byte.
+func IsShortException(header []byte, funcCode byte) bool {
+	return funcCode&0x80 != 0 && len(header) >= tcpHeaderSize && header[4] == 0 && header[5] == 2
+}
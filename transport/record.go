@@ -0,0 +1,339 @@
+package transport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/goburrow/modbus"
+)
+
+// ErrNotRecorded is returned by a replay for a request that isn't in the recording.
+var ErrNotRecorded = errors.New("request not in the recording")
+
+// Frame is one recorded exchange, the ADUs are what the transport sent and
+// returned after any repairs it made. Raw is the response as it came off the
+// wire when the transport repaired it, replays answer with Response.
+type Frame struct {
+	Time      time.Time     `json:"time"`
+	Duration  time.Duration `json:"duration"`
+	Transport string        `json:"transport"`
+	Request   HexBytes      `json:"request"`
+	Response  HexBytes      `json:"response,omitempty"`
+	Raw       HexBytes      `json:"raw,omitempty"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// HexBytes marshals to a hex string, so recordings can be read and edited by hand.
+type HexBytes []byte
+
+func (h HexBytes) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(h)), nil
+}
+
+func (h *HexBytes) UnmarshalText(text []byte) (err error) {
+	*h, err = hex.DecodeString(string(text))
+	return
+}
+
+// Recorder writes the frames of one or more handlers to a JSON lines file.
+type Recorder struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+	once   sync.Once
+}
+
+// NewRecorder records to w.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w}
+}
+
+// CreateRecorder records to the named file, appending if it exists.
+func CreateRecorder(name string) (*Recorder, error) {
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{w: f, closer: f}, nil
+}
+
+// Wrap returns h with every exchange recorded as the named transport.
+func (r *Recorder) Wrap(name string, h modbus.ClientHandler) modbus.ClientHandler {
+	return &recordingHandler{ClientHandler: h, name: name, recorder: r}
+}
+
+func (r *Recorder) write(f Frame) error {
+	b, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, err = r.w.Write(append(b, '\n'))
+	return err
+}
+
+// Close closes the file when the recorder opened it.
+func (r *Recorder) Close() (err error) {
+	r.once.Do(func() {
+		if r.closer != nil {
+			err = r.closer.Close()
+		}
+	})
+	return
+}
+
+// rawSender is a transport that repairs what it receives, it returns the
+// response before the repair too
+type rawSender interface {
+	sendRaw(aduRequest []byte) (aduResponse, raw []byte, err error)
+}
+
+type recordingHandler struct {
+	modbus.ClientHandler
+	name     string
+	recorder *Recorder
+}
+
+func (h *recordingHandler) Send(aduRequest []byte) ([]byte, error) {
+	var aduResponse, raw []byte
+	var err error
+
+	start := time.Now()
+	if rs, isa := h.ClientHandler.(rawSender); isa {
+		aduResponse, raw, err = rs.sendRaw(aduRequest)
+	} else {
+		aduResponse, err = h.ClientHandler.Send(aduRequest)
+	}
+
+	f := Frame{
+		Time:      start,
+		Duration:  time.Since(start),
+		Transport: h.name,
+		Request:   aduRequest,
+		Response:  aduResponse,
+	}
+	if raw != nil && !bytes.Equal(raw, aduResponse) {
+		f.Raw = raw
+	}
+	if err != nil {
+		f.Error = err.Error()
+	}
+
+	if werr := h.recorder.write(f); werr != nil && err == nil {
+		return nil, fmt.Errorf("failed to record: %w", werr)
+	}
+
+	return aduResponse, err
+}
+
+func (h *recordingHandler) Close() error {
+	if closer, isa := h.ClientHandler.(io.Closer); isa {
+		closer.Close()
+	}
+	return h.recorder.Close()
+}
+
+// ReadFrames reads a recording.
+func ReadFrames(r io.Reader) ([]Frame, error) {
+	var frames []Frame
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var f Frame
+		if err := json.Unmarshal(scanner.Bytes(), &f); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		frames = append(frames, f)
+	}
+
+	return frames, scanner.Err()
+}
+
+// ReplayHandler answers requests from a recording of one transport. Requests
+// are matched on their PDU, the earliest unused answer wins and answers are
+// reused once every match has been.
+type ReplayHandler struct {
+	modbus.Packager
+
+	// Take as long as the device did
+	Realtime bool
+
+	name   string
+	mu     sync.Mutex
+	frames []Frame
+	pdus   []*modbus.ProtocolDataUnit
+	used   []bool
+}
+
+// NewReplayHandler replays the frames of the named transport, one of tcp,
+// winet or rtu.
+func NewReplayHandler(name string, frames []Frame) (*ReplayHandler, error) {
+	h := &ReplayHandler{name: name}
+
+	var slaveID byte
+	for _, f := range frames {
+		if f.Transport == name && len(f.Request) > 0 {
+			h.frames = append(h.frames, f)
+		}
+	}
+
+	if len(h.frames) == 0 {
+		return nil, fmt.Errorf("no %s frames in the recording", name)
+	}
+
+	first := h.frames[0].Request
+	switch name {
+	case "tcp":
+		if len(first) > tcpHeaderSize {
+			slaveID = first[6]
+		}
+		h.Packager = &borkedTCPPackager{SlaveID: slaveID}
+	case "winet", "http":
+		if len(first) > 1 {
+			slaveID = first[1]
+		}
+		h.Packager = &httpPackager{SlaveID: slaveID}
+	case "rtu":
+		rtu := modbus.NewRTUClientHandler("")
+		rtu.SlaveId = first[0]
+		h.Packager = rtu
+	default:
+		return nil, fmt.Errorf("can't replay %s frames", name)
+	}
+
+	for _, f := range h.frames {
+		pdu, err := h.Packager.Decode(f.Request)
+		if err != nil {
+			return nil, fmt.Errorf("recorded request % x: %w", []byte(f.Request), err)
+		}
+		h.pdus = append(h.pdus, pdu)
+	}
+	h.used = make([]bool, len(h.frames))
+
+	return h, nil
+}
+
+// Send answers the request as it was answered when recorded.
+func (h *ReplayHandler) Send(aduRequest []byte) ([]byte, error) {
+	pdu, err := h.Packager.Decode(aduRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	f, found := h.match(pdu)
+	if !found {
+		return nil, fmt.Errorf("%w: function %d % x", ErrNotRecorded, pdu.FunctionCode, pdu.Data)
+	}
+
+	if h.Realtime {
+		time.Sleep(f.Duration)
+	}
+
+	if f.Error != "" {
+		return nil, errors.New(f.Error)
+	}
+
+	aduResponse := append([]byte(nil), f.Response...)
+
+	// Transaction ids are numbered afresh
+	if h.name == "tcp" && len(aduResponse) >= 2 && len(aduRequest) >= 2 {
+		copy(aduResponse[:2], aduRequest[:2])
+	}
+
+	return aduResponse, nil
+}
+
+func (h *ReplayHandler) match(pdu *modbus.ProtocolDataUnit) (Frame, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	reuse := -1
+	for i, p := range h.pdus {
+		if p.FunctionCode != pdu.FunctionCode || string(p.Data) != string(pdu.Data) {
+			continue
+		}
+
+		if !h.used[i] {
+			h.used[i] = true
+			return h.frames[i], true
+		}
+
+		if reuse < 0 {
+			reuse = i
+		}
+	}
+
+	if reuse < 0 {
+		return Frame{}, false
+	}
+
+	// Every answer has been given, start over
+	for i, p := range h.pdus {
+		if p.FunctionCode == pdu.FunctionCode && string(p.Data) == string(pdu.Data) {
+			h.used[i] = false
+		}
+	}
+	h.used[reuse] = true
+
+	return h.frames[reuse], true
+}
+
+// Replay opens a client that answers from a recording, with a backend for
+// each transport in the order they first appear.
+func Replay(frames []Frame) (*Client, error) {
+	var backends []Backend
+
+	seen := map[string]bool{}
+	for _, f := range frames {
+		if seen[f.Transport] {
+			continue
+		}
+		seen[f.Transport] = true
+
+		h, err := NewReplayHandler(f.Transport, frames)
+		if err != nil {
+			return nil, err
+		}
+		backends = append(backends, Backend{Name: f.Transport, Handler: h})
+	}
+
+	if len(backends) == 0 {
+		return nil, errors.New("nothing in the recording")
+	}
+
+	return NewClient(backends...), nil
+}
+
+// ReplayFile opens a client that answers from the named recording.
+func ReplayFile(name string) (*Client, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	frames, err := ReadFrames(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return Replay(frames)
+}
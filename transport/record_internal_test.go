@@ -0,0 +1,125 @@
+package transport
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/goburrow/modbus"
+	"github.com/stretchr/testify/require"
+)
+
+// tcpDevice answers input register reads with a counter and fails the rest
+type tcpDevice struct {
+	borkedTCPPackager
+	reads uint16
+}
+
+func (d *tcpDevice) Send(aduRequest []byte) ([]byte, error) {
+	if aduRequest[tcpHeaderSize] != modbus.FuncCodeReadInputRegisters {
+		return nil, errors.New("i/o timeout")
+	}
+
+	d.reads++
+	response := append([]byte(nil), aduRequest[:tcpHeaderSize]...)
+	response[5] = 5
+	return append(response, modbus.FuncCodeReadInputRegisters, 2, byte(d.reads>>8), byte(d.reads)), nil
+}
+
+func TestRecordReplay(t *testing.T) {
+	requires := require.New(t)
+
+	var buf bytes.Buffer
+	recorder := NewRecorder(&buf)
+	client := modbus.NewClient(recorder.Wrap("tcp", &tcpDevice{borkedTCPPackager: borkedTCPPackager{SlaveID: 1}}))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.ReadInputRegisters(4999, 1)
+		requires.NoError(err)
+	}
+
+	_, err := client.ReadHoldingRegisters(4999, 1)
+	requires.EqualError(err, "i/o timeout")
+
+	frames, err := ReadFrames(&buf)
+	requires.NoError(err)
+	requires.Len(frames, 3)
+	requires.Equal("tcp", frames[0].Transport)
+	requires.Equal(HexBytes{0, 1, 0, 0, 0, 6, 1, 4, 0x13, 0x87, 0, 1}, frames[0].Request)
+	requires.Equal("i/o timeout", frames[2].Error)
+
+	replay, err := Replay(frames)
+	requires.NoError(err)
+
+	// A fresh client numbers its transactions from 1 again, start further on
+	h := replay.backends[0].Handler.(*ReplayHandler)
+	h.Packager.(*borkedTCPPackager).transactionId = 100
+
+	for _, want := range []byte{1, 2, 1} {
+		results, err := replay.ReadInputRegisters(4999, 1)
+		requires.NoError(err)
+		requires.Equal([]byte{0, want}, results)
+	}
+
+	_, err = replay.ReadHoldingRegisters(4999, 1)
+	requires.EqualError(err, "i/o timeout")
+
+	_, err = replay.ReadInputRegisters(5000, 1)
+	requires.ErrorIs(err, ErrNotRecorded)
+}
+
+func TestRecordRaw(t *testing.T) {
+	requires := require.New(t)
+
+	server, conn := net.Pipe()
+	go func() {
+		defer server.Close()
+		buf := make([]byte, tcpMaxLength)
+		for _, response := range [][]byte{
+			// WiNet-S short exception, the length says 2 for 3 bytes
+			{0, 1, 0, 0, 0, 2, 1, 0x84, 2},
+			{0, 2, 0, 0, 0, 5, 1, 4, 2, 0, 0xff},
+		} {
+			if _, err := server.Read(buf); err != nil {
+				return
+			}
+			server.Write(response)
+		}
+	}()
+
+	h := &BorkedTCPHandler{}
+	h.Timeout = tcpTimeout
+	h.conn = conn
+
+	var buf bytes.Buffer
+	recorded := NewRecorder(&buf).Wrap("tcp", h)
+
+	response, err := recorded.Send([]byte{0, 1, 0, 0, 0, 6, 1, 4, 0x13, 0x87, 0, 1})
+	requires.NoError(err)
+	requires.Equal([]byte{0, 1, 0, 0, 0, 3, 1, 0x84, 2}, response)
+
+	_, err = recorded.Send([]byte{0, 2, 0, 0, 0, 6, 1, 4, 0x13, 0x87, 0, 1})
+	requires.NoError(err)
+	conn.Close()
+
+	frames, err := ReadFrames(&buf)
+	requires.NoError(err)
+	requires.Len(frames, 2)
+	requires.Equal(HexBytes{0, 1, 0, 0, 0, 3, 1, 0x84, 2}, frames[0].Response)
+	requires.Equal(HexBytes{0, 1, 0, 0, 0, 2, 1, 0x84, 2}, frames[0].Raw)
+
+	// Frames that needed no repair don't repeat the response
+	requires.Equal(HexBytes{0, 2, 0, 0, 0, 5, 1, 4, 2, 0, 0xff}, frames[1].Response)
+	requires.Nil(frames[1].Raw)
+}
+
+func TestDialReplay(t *testing.T) {
+	requires := require.New(t)
+
+	_, err := Dial("replay:///nonexistent.jsonl")
+	requires.Error(err)
+
+	_, err = NewReplayHandler("tcp", nil)
+	requires.Error(err)
+}
@@ -0,0 +1,302 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	websocketPath                   = "/ws/home/overview"
+	websocketServiceMessageTemplate = `{"lang":"en_us","token":"%s","dev_id":"%d","service":"%s","time123456":"%d"}`
+
+	// Services the WiNet-S web interface subscribes to for its overview page
+	ServiceReal        = "real"
+	ServiceRealBattery = "real_battery"
+	ServiceDirect      = "direct"
+)
+
+// RealtimeValue is a single value as reported by the WiNet-S websocket.
+type RealtimeValue struct {
+	Service string
+	Name    string
+	Value   string
+	Unit    string
+}
+
+// WinetDevice is a device attached to a WiNet-S dongle.
+type WinetDevice struct {
+	ID     int    `json:"id"`
+	Code   int    `json:"code"`
+	Type   int    `json:"type"`
+	Serial string `json:"serial,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Model  string `json:"model,omitempty"`
+}
+
+// WebsocketClient reads the real-time values the WiNet-S web interface displays
+// over its websocket instead of querying registers one at a time.
+type WebsocketClient struct {
+	// Connect string
+	Host   string
+	WSPort int
+
+	// Connect & Read timeout
+	Timeout time.Duration
+	// Transmission logger
+	Logger *log.Logger
+	// Services to query, the first is required to succeed, the rest are
+	// optional as not every device supports them (eg real_battery)
+	Services []string
+
+	mu      sync.Mutex
+	conn    *websocket.Conn
+	token   string
+	devices []jsonDevice
+}
+
+// NewWebsocketClient allocates a new WebsocketClient.
+func NewWebsocketClient(host string) *WebsocketClient {
+	return &WebsocketClient{
+		Host:     host,
+		WSPort:   websocketPort,
+		Timeout:  httpTimeout,
+		Services: []string{ServiceReal, ServiceRealBattery, ServiceDirect},
+	}
+}
+
+// Connect establishes the websocket and retrieves a token.
+func (mb *WebsocketClient) Connect() error {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+
+	return mb.connect()
+}
+
+func (mb *WebsocketClient) connect() error {
+	if mb.conn != nil {
+		return nil
+	}
+
+	uri := url.URL{
+		Scheme: "ws",
+		Host:   net.JoinHostPort(mb.Host, strconv.Itoa(mb.WSPort)),
+		Path:   websocketPath,
+	}
+
+	dialer := *websocket.DefaultDialer
+	dialer.HandshakeTimeout = mb.Timeout
+
+	c, _, err := dialer.Dial(uri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failure to dial websocket: %w", err)
+	}
+
+	mb.setDeadline(c)
+	token, devices, err := websocketHandshake(c)
+	if err != nil {
+		c.Close()
+		return err
+	}
+
+	mb.conn = c
+	mb.token = token
+	mb.devices = devices.List
+
+	return nil
+}
+
+// Model returns the model of the first device attached to the dongle.
+func (mb *WebsocketClient) Model() string {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+
+	if len(mb.devices) == 0 {
+		return ""
+	}
+
+	return mb.devices[0].DevModel
+}
+
+// Devices returns the devices attached to the dongle.
+func (mb *WebsocketClient) Devices() []WinetDevice {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+
+	devices := make([]WinetDevice, len(mb.devices))
+	for i, d := range mb.devices {
+		devices[i] = WinetDevice{
+			ID:     d.DevID,
+			Code:   d.DevCode,
+			Type:   d.DevType,
+			Serial: d.DevSn,
+			Name:   d.DevName,
+			Model:  d.DevModel,
+		}
+	}
+
+	return devices
+}
+
+// Realtime queries each of the configured services and returns the combined values.
+func (mb *WebsocketClient) Realtime() ([]RealtimeValue, error) {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+
+	if err := mb.connect(); err != nil {
+		return nil, err
+	}
+
+	var values []RealtimeValue
+	for i, service := range mb.Services {
+		result, err := mb.query(service)
+		if err != nil {
+			// A broken connection is no good to anyone, start over next time.
+			mb.close()
+			return nil, err
+		}
+
+		if result == nil {
+			if i == 0 {
+				return nil, fmt.Errorf("websocket service %q is not supported", service)
+			}
+			continue
+		}
+
+		values = append(values, result...)
+	}
+
+	return values, nil
+}
+
+func (mb *WebsocketClient) query(service string) ([]RealtimeValue, error) {
+	mb.setDeadline(mb.conn)
+
+	msg := fmt.Sprintf(websocketServiceMessageTemplate, mb.token, mb.devices[0].DevID, service, time.Now().UTC().UnixNano()/1e6)
+	mb.logf("modbus: sent %s\n", msg)
+
+	if err := mb.conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+		return nil, fmt.Errorf("failed to send %s request to websocket: %w", service, err)
+	}
+
+	var list jsonRealtimeMessage
+	message := jsonMessage{ResultData: &list}
+	if err := mb.conn.ReadJSON(&message); err != nil {
+		return nil, fmt.Errorf("failed to retrieve %s response from websocket: %w", service, err)
+	}
+
+	mb.logf("modbus: received %v\n", message)
+
+	if message.ResultCode != 1 {
+		return nil, nil
+	}
+
+	var values []RealtimeValue
+	for _, item := range list.List {
+		if service == ServiceDirect {
+			values = append(values,
+				RealtimeValue{Service: service, Name: item.Name + ".voltage", Value: item.Voltage, Unit: item.VoltageUnit},
+				RealtimeValue{Service: service, Name: item.Name + ".current", Value: item.Current, Unit: item.CurrentUnit},
+			)
+			continue
+		}
+
+		values = append(values, RealtimeValue{Service: service, Name: item.DataName, Value: item.DataValue, Unit: item.DataUnit})
+	}
+
+	return values, nil
+}
+
+// Close the websocket.
+func (mb *WebsocketClient) Close() error {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+
+	return mb.close()
+}
+
+func (mb *WebsocketClient) close() (err error) {
+	if mb.conn != nil {
+		err = mb.conn.Close()
+		mb.conn = nil
+	}
+	mb.token = ""
+	return
+}
+
+func (mb *WebsocketClient) setDeadline(c *websocket.Conn) {
+	if mb.Timeout > 0 {
+		c.SetReadDeadline(time.Now().Add(mb.Timeout))
+		c.SetWriteDeadline(time.Now().Add(mb.Timeout))
+	}
+}
+
+func (mb *WebsocketClient) logf(format string, v ...interface{}) {
+	if mb.Logger != nil {
+		mb.Logger.Printf(format, v...)
+	}
+}
+
+// websocketHandshake retrieves a token and the list of attached devices.
+func websocketHandshake(c *websocket.Conn) (string, *jsonDeviceListMessage, error) {
+	if err := c.WriteMessage(websocket.TextMessage, []byte(websocketConnectMessage)); err != nil {
+		return "", nil, fmt.Errorf("failed to send websocket connect message: %w", err)
+	}
+
+	var connectMessage jsonConnectMessage
+	var message jsonMessage
+	message.ResultData = &connectMessage
+
+	if err := c.ReadJSON(&message); err != nil {
+		return "", nil, fmt.Errorf("failed to retrieve connect message from websocket: %w", err)
+	}
+
+	if message.ResultCode != 1 {
+		return "", nil, fmt.Errorf("unexpected response from websocket: %s (%d)", message.ResultMsg, message.ResultCode)
+	}
+
+	token := connectMessage.Token
+	if token == "" {
+		return "", nil, errors.New("failed to find token in connect message from websocket")
+	}
+
+	if err := c.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(websocketDeviceListMessageTemplate, token))); err != nil {
+		return "", nil, fmt.Errorf("failed to send device list request to websocket: %w", err)
+	}
+
+	var deviceListMessage jsonDeviceListMessage
+	message.ResultData = &deviceListMessage
+	if err := c.ReadJSON(&message); err != nil {
+		return "", nil, fmt.Errorf("failed to retrieve device list from websocket: %w", err)
+	}
+
+	if len(deviceListMessage.List) == 0 {
+		return "", nil, errors.New("no devices found in device list from websocket")
+	}
+
+	return token, &deviceListMessage, nil
+}
+
+// Float converts the value into a number, the dongle uses "--" and empty
+// strings for values it doesn't have.
+func (v RealtimeValue) Float() (float64, bool) {
+	s := strings.TrimSpace(v.Value)
+	if s == "" || s == "--" {
+		return 0, false
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return f, true
+}
@@ -0,0 +1,122 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+const websocketRealResponse = `{
+	"result_code":  1,
+	"result_msg":   "success",
+	"result_data":  {
+		"service":  "real",
+		"list": [
+			{"id": 1, "data_name": "I18N_COMMON_TOTAL_DCPOWER", "data_value": "4.27", "data_unit": "kW"},
+			{"id": 2, "data_name": "I18N_COMMON_BATTERY_SOC", "data_value": "--", "data_unit": "%"}
+		],
+		"count": 2
+	}
+}`
+
+const websocketDirectResponse = `{
+	"result_code":  1,
+	"result_msg":   "success",
+	"result_data":  {
+		"service":  "direct",
+		"list": [
+			{"id": 1, "name": "MPPT1", "voltage": "351.2", "voltage_unit": "V", "current": "6.1", "current_unit": "A"}
+		],
+		"count": 1
+	}
+}`
+
+func TestWebsocketClient(t *testing.T) {
+	requires := require.New(t)
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requires.Equal(websocketPath, r.URL.Path)
+
+		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		requires.NoError(err)
+		defer c.Close()
+
+		for {
+			mt, message, err := c.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			if bytes.Equal([]byte(websocketConnectMessage), message) {
+				requires.NoError(c.WriteMessage(mt, []byte(websocketConnectResponse)))
+				continue
+			}
+
+			if bytes.Equal([]byte(fmt.Sprintf(websocketDeviceListMessageTemplate, "04794176-350b-4a71-b3d9-fcb0a0582920")), message) {
+				requires.NoError(c.WriteMessage(mt, []byte(websocketDeviceListResponse)))
+				continue
+			}
+
+			var req map[string]string
+			requires.NoError(json.Unmarshal(message, &req))
+			requires.Equal("04794176-350b-4a71-b3d9-fcb0a0582920", req["token"])
+			requires.Equal("1", req["dev_id"])
+
+			switch req["service"] {
+			case ServiceReal:
+				requires.NoError(c.WriteMessage(mt, []byte(websocketRealResponse)))
+			case ServiceRealBattery:
+				requires.NoError(c.WriteMessage(mt, []byte(`{"result_code": 106, "result_msg": "unsupported"}`)))
+			case ServiceDirect:
+				requires.NoError(c.WriteMessage(mt, []byte(websocketDirectResponse)))
+			default:
+				t.Errorf("unexpected service %q", req["service"])
+			}
+		}
+	}))
+	defer svr.Close()
+
+	host, port, err := net.SplitHostPort(svr.Listener.Addr().String())
+	requires.NoError(err)
+
+	iPort, err := strconv.Atoi(port)
+	requires.NoError(err)
+
+	client := NewWebsocketClient(host)
+	client.WSPort = iPort
+	defer client.Close()
+
+	values, err := client.Realtime()
+	requires.NoError(err)
+	requires.Equal("SH10RT", client.Model())
+	requires.Equal([]WinetDevice{
+		{ID: 1, Code: 3587, Type: 35, Serial: "A1234567890", Name: "SH10RT(COM1-001)", Model: "SH10RT"},
+		{ID: 2, Code: 8427, Type: 44, Serial: "S1234567890", Name: "SBR224(COM1-200)", Model: "SBR224"},
+	}, client.Devices())
+	requires.Equal([]RealtimeValue{
+		{Service: ServiceReal, Name: "I18N_COMMON_TOTAL_DCPOWER", Value: "4.27", Unit: "kW"},
+		{Service: ServiceReal, Name: "I18N_COMMON_BATTERY_SOC", Value: "--", Unit: "%"},
+		{Service: ServiceDirect, Name: "MPPT1.voltage", Value: "351.2", Unit: "V"},
+		{Service: ServiceDirect, Name: "MPPT1.current", Value: "6.1", Unit: "A"},
+	}, values)
+
+	f, isa := values[0].Float()
+	requires.True(isa)
+	requires.Equal(4.27, f)
+
+	_, isa = values[1].Float()
+	requires.False(isa)
+
+	// Second round reuses the connection
+	values, err = client.Realtime()
+	requires.NoError(err)
+	requires.Len(values, 4)
+}
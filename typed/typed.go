@@ -0,0 +1,105 @@
+// Package typed has Go types of the registers of each model, generated from
+// the register definition. Models with exactly the same registers share a
+// type named after the first of them, SG10KTL also reads the SG30KTL and the
+// other models its Models method lists.
+package typed
+
+//go:generate go run ../cmd/sungrow-typegen -o typed_gen.go
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/freman/sungrow"
+	"github.com/goburrow/modbus"
+)
+
+// Device is a model's registers.
+type Device interface {
+	// Read reads the registers from the device
+	Read(client sungrow.Modbus) error
+	// Models returns the models that have the registers
+	Models() []string
+}
+
+// New returns the registers of the model, nil for models it doesn't know.
+func New(model string) Device {
+	if fn, isa := constructors[model]; isa {
+		return fn()
+	}
+	return nil
+}
+
+// field is how to read one register and where to put it
+type field struct {
+	holding  bool
+	register sungrow.Register
+	set      func(r *sungrow.Register)
+}
+
+func read(client sungrow.Modbus, fields []field) error {
+	for _, f := range fields {
+		fn := client.ReadInputRegisters
+		if f.holding {
+			fn = client.ReadHoldingRegisters
+		}
+
+		r := f.register
+		results, err := fn(uint16(r.Address-1), uint16(r.Size()))
+		if err != nil {
+			// Not supported after all
+			var mbErr *modbus.ModbusError
+			if errors.As(err, &mbErr) {
+				continue
+			}
+			return err
+		}
+
+		if err := r.Decode(results); err != nil {
+			return fmt.Errorf("register %d: %w", r.Address, err)
+		}
+
+		f.set(&r)
+	}
+
+	return nil
+}
+
+func number(r *sungrow.Register) float64 {
+	f, _ := r.Float()
+	return f
+}
+
+func text(r *sungrow.Register) string {
+	s, _ := r.Value.(string)
+	return s
+}
+
+func enum(name string, v int, labels map[int]string) string {
+	if l, isa := labels[v]; isa {
+		return l
+	}
+	return fmt.Sprintf("%s(%d)", name, v)
+}
+
+func flags(v int, labels map[int]string) []string {
+	var masks []int
+	for mask := range labels {
+		if v&mask != 0 {
+			masks = append(masks, mask)
+		}
+	}
+	sort.Ints(masks)
+
+	set := make([]string, len(masks))
+	for i, mask := range masks {
+		set[i] = labels[mask]
+	}
+	return set
+}
+
+func join(flags []string) string {
+	return strings.Join(flags, ", ")
+}
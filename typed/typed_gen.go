@@ -0,0 +1,5213 @@
+// Code generated by sungrow-typegen. DO NOT EDIT.
+
+package typed
+
+import "github.com/freman/sungrow"
+
+// BatteryType is an enumerated value.
+type BatteryType uint16
+
+const (
+	BatteryTypeLeadAcidNarada BatteryType = 0
+	BatteryTypeLiIonSamsung   BatteryType = 1
+	BatteryTypeNoBattery      BatteryType = 2
+	BatteryTypeOtherLeadAcid  BatteryType = 3
+	BatteryTypeLiIonUS2000A   BatteryType = 4
+	BatteryTypeLiIonLG        BatteryType = 5
+	BatteryTypeLiIonUS2000B   BatteryType = 6
+	BatteryTypeLiIonGCL       BatteryType = 7
+	BatteryTypeLiIonBlueSun   BatteryType = 8
+	BatteryTypeLiIonSungrow   BatteryType = 9
+	BatteryTypeLiIonBYD       BatteryType = 10
+)
+
+var batteryTypeLabels = map[int]string{
+	0:  "Lead-acid Narada",
+	1:  "Li-ion Samsung",
+	2:  "No battery",
+	3:  "Other Lead-acid",
+	4:  "Li-ion US2000A",
+	5:  "Li-ion LG",
+	6:  "Li-ion US2000B",
+	7:  "Li-ion GCL",
+	8:  "Li-ion BlueSun",
+	9:  "Li-ion Sungrow",
+	10: "Li-ion BYD",
+}
+
+func (v BatteryType) String() string { return enum("BatteryType", int(v), batteryTypeLabels) }
+
+// ChargeDischargeCommand is an enumerated value.
+type ChargeDischargeCommand uint16
+
+const (
+	ChargeDischargeCommandCharge    ChargeDischargeCommand = 170
+	ChargeDischargeCommandDischarge ChargeDischargeCommand = 187
+	ChargeDischargeCommandStop      ChargeDischargeCommand = 204
+)
+
+var chargeDischargeCommandLabels = map[int]string{
+	170: "Charge",
+	187: "Discharge",
+	204: "Stop",
+}
+
+func (v ChargeDischargeCommand) String() string {
+	return enum("ChargeDischargeCommand", int(v), chargeDischargeCommandLabels)
+}
+
+// CurrentTransformer is an enumerated value.
+type CurrentTransformer uint16
+
+const (
+	CurrentTransformerInternal CurrentTransformer = 0
+	CurrentTransformerExternal CurrentTransformer = 1
+)
+
+var currentTransformerLabels = map[int]string{
+	0: "internal",
+	1: "external",
+}
+
+func (v CurrentTransformer) String() string {
+	return enum("CurrentTransformer", int(v), currentTransformerLabels)
+}
+
+// DeviceTypeCode is an enumerated value.
+type DeviceTypeCode uint16
+
+const (
+	DeviceTypeCodeSG10KTL     DeviceTypeCode = 38
+	DeviceTypeCodeSG30KTL     DeviceTypeCode = 39
+	DeviceTypeCodeSG15KTL     DeviceTypeCode = 40
+	DeviceTypeCodeSG12KTL     DeviceTypeCode = 41
+	DeviceTypeCodeSG20KTL     DeviceTypeCode = 42
+	DeviceTypeCodeSG30KU      DeviceTypeCode = 44
+	DeviceTypeCodeSG36KTL     DeviceTypeCode = 45
+	DeviceTypeCodeSG36KU      DeviceTypeCode = 46
+	DeviceTypeCodeSG40KTL     DeviceTypeCode = 47
+	DeviceTypeCodeSG30KTLMV31 DeviceTypeCode = 112
+	DeviceTypeCodeSG34KJ      DeviceTypeCode = 114
+	DeviceTypeCodeLPP34KSG    DeviceTypeCode = 115
+	DeviceTypeCodeSG36KTLM    DeviceTypeCode = 116
+	DeviceTypeCodeSG60KTL     DeviceTypeCode = 271
+	DeviceTypeCodeSG50KTLM    DeviceTypeCode = 283
+	DeviceTypeCodeSG60KTLM    DeviceTypeCode = 305
+	DeviceTypeCodeSG60KUM     DeviceTypeCode = 306
+	DeviceTypeCodeSG33KTLM    DeviceTypeCode = 308
+	DeviceTypeCodeSG40KTLM    DeviceTypeCode = 309
+	DeviceTypeCodeSG60KU      DeviceTypeCode = 310
+	DeviceTypeCodeSG49K5J     DeviceTypeCode = 311
+	DeviceTypeCodeSG80KTL     DeviceTypeCode = 312
+	DeviceTypeCodeSG80KTLM    DeviceTypeCode = 313
+	DeviceTypeCodeSG125HV     DeviceTypeCode = 315
+	DeviceTypeCodeSG12KTLM    DeviceTypeCode = 316
+	DeviceTypeCodeSG33K3J     DeviceTypeCode = 317
+	DeviceTypeCodeSG10KTLM    DeviceTypeCode = 318
+	DeviceTypeCodeSG8KTLM     DeviceTypeCode = 319
+	DeviceTypeCodeSG30KTLM    DeviceTypeCode = 321
+	DeviceTypeCodeSG15KTLM    DeviceTypeCode = 322
+	DeviceTypeCodeSG20KTLM    DeviceTypeCode = 323
+	DeviceTypeCodeSG5KTLMT    DeviceTypeCode = 327
+	DeviceTypeCodeSG6KTLMT    DeviceTypeCode = 328
+	DeviceTypeCodeSG17KTLM    DeviceTypeCode = 329
+	DeviceTypeCodeSG111HV     DeviceTypeCode = 332
+	DeviceTypeCodeSH5KV13     DeviceTypeCode = 3331
+	DeviceTypeCodeSH3K6       DeviceTypeCode = 3334
+	DeviceTypeCodeSH4K6       DeviceTypeCode = 3335
+	DeviceTypeCodeSH5K20      DeviceTypeCode = 3337
+	DeviceTypeCodeSH3K630     DeviceTypeCode = 3338
+	DeviceTypeCodeSH4K630     DeviceTypeCode = 3339
+	DeviceTypeCodeSH5K30      DeviceTypeCode = 3340
+	DeviceTypeCodeSH3d6RS     DeviceTypeCode = 3341
+	DeviceTypeCodeSH4d6RS     DeviceTypeCode = 3342
+	DeviceTypeCodeSH5d0RS     DeviceTypeCode = 3343
+	DeviceTypeCodeSH6d0RS     DeviceTypeCode = 3344
+	DeviceTypeCodeSH5d0RT     DeviceTypeCode = 3584
+	DeviceTypeCodeSH6d0RT     DeviceTypeCode = 3585
+	DeviceTypeCodeSH8d0RT     DeviceTypeCode = 3586
+	DeviceTypeCodeSH10RT      DeviceTypeCode = 3587
+	DeviceTypeCodeSG5d0RT     DeviceTypeCode = 9264
+	DeviceTypeCodeSG6d0RT     DeviceTypeCode = 9265
+	DeviceTypeCodeSG8d0RT     DeviceTypeCode = 9266
+	DeviceTypeCodeSG10RT      DeviceTypeCode = 9267
+	DeviceTypeCodeSG12RT      DeviceTypeCode = 9268
+	DeviceTypeCodeSG15RT      DeviceTypeCode = 9269
+	DeviceTypeCodeSG17RT      DeviceTypeCode = 9270
+	DeviceTypeCodeSG20RT      DeviceTypeCode = 9271
+	DeviceTypeCodeSG7d0RT     DeviceTypeCode = 9276
+	DeviceTypeCodeSG3d0RT     DeviceTypeCode = 9277
+	DeviceTypeCodeSG4d0RT     DeviceTypeCode = 9278
+	DeviceTypeCodeSG33CX      DeviceTypeCode = 11264
+	DeviceTypeCodeSG40CX      DeviceTypeCode = 11265
+	DeviceTypeCodeSG50CX      DeviceTypeCode = 11266
+	DeviceTypeCodeSG125HV20   DeviceTypeCode = 11267
+	DeviceTypeCodeSG110CX     DeviceTypeCode = 11270
+	DeviceTypeCodeSG36CXUS    DeviceTypeCode = 11274
+	DeviceTypeCodeSG60CXUS    DeviceTypeCode = 11275
+	DeviceTypeCodeSG250HX     DeviceTypeCode = 11276
+	DeviceTypeCodeSG10KTLMT   DeviceTypeCode = 11279
+	DeviceTypeCodeSG30CX      DeviceTypeCode = 11280
+	DeviceTypeCodeSG250HXUS   DeviceTypeCode = 11281
+	DeviceTypeCodeSG100CX     DeviceTypeCode = 11282
+	DeviceTypeCodeSG250HXIN   DeviceTypeCode = 11283
+	DeviceTypeCodeSG25CXSA    DeviceTypeCode = 11285
+	DeviceTypeCodeSG75CX      DeviceTypeCode = 11298
+)
+
+var deviceTypeCodeLabels = map[int]string{
+	38:    "SG10KTL",
+	39:    "SG30KTL",
+	40:    "SG15KTL",
+	41:    "SG12KTL",
+	42:    "SG20KTL",
+	44:    "SG30KU",
+	45:    "SG36KTL",
+	46:    "SG36KU",
+	47:    "SG40KTL",
+	112:   "SG30KTL-M-V31",
+	114:   "SG34KJ",
+	115:   "LP_P34KSG",
+	116:   "SG36KTL-M",
+	271:   "SG60KTL",
+	283:   "SG50KTL-M",
+	305:   "SG60KTL-M",
+	306:   "SG60KU-M",
+	308:   "SG33KTL-M",
+	309:   "SG40KTL-M",
+	310:   "SG60KU",
+	311:   "SG49K5J",
+	312:   "SG80KTL",
+	313:   "SG80KTL-M",
+	315:   "SG125HV",
+	316:   "SG12KTL-M",
+	317:   "SG33K3J",
+	318:   "SG10KTL-M",
+	319:   "SG8KTL-M",
+	321:   "SG30KTL-M",
+	322:   "SG15KTL-M",
+	323:   "SG20KTL-M",
+	327:   "SG5KTL-MT",
+	328:   "SG6KTL-MT",
+	329:   "SG17KTL-M",
+	332:   "SG111HV",
+	3331:  "SH5K-V13",
+	3334:  "SH3K6",
+	3335:  "SH4K6",
+	3337:  "SH5K-20",
+	3338:  "SH3K6-30",
+	3339:  "SH4K6-30",
+	3340:  "SH5K-30",
+	3341:  "SH3.6RS",
+	3342:  "SH4.6RS",
+	3343:  "SH5.0RS",
+	3344:  "SH6.0RS",
+	3584:  "SH5.0RT",
+	3585:  "SH6.0RT",
+	3586:  "SH8.0RT",
+	3587:  "SH10RT",
+	9264:  "SG5.0RT",
+	9265:  "SG6.0RT",
+	9266:  "SG8.0RT",
+	9267:  "SG10RT",
+	9268:  "SG12RT",
+	9269:  "SG15RT",
+	9270:  "SG17RT",
+	9271:  "SG20RT",
+	9276:  "SG7.0RT",
+	9277:  "SG3.0RT",
+	9278:  "SG4.0RT",
+	11264: "SG33CX",
+	11265: "SG40CX",
+	11266: "SG50CX",
+	11267: "SG125HV-20",
+	11270: "SG110CX",
+	11274: "SG36CX-US",
+	11275: "SG60CX-US",
+	11276: "SG250HX",
+	11279: "SG10KTL-MT",
+	11280: "SG30CX",
+	11281: "SG250HX-US",
+	11282: "SG100CX",
+	11283: "SG250HX-IN",
+	11285: "SG25CX-SA",
+	11298: "SG75CX",
+}
+
+func (v DeviceTypeCode) String() string { return enum("DeviceTypeCode", int(v), deviceTypeCodeLabels) }
+
+// EMSModeSelection is an enumerated value.
+type EMSModeSelection uint16
+
+const (
+	EMSModeSelectionSelfConsumptionMode EMSModeSelection = 0
+	EMSModeSelectionForcedMode          EMSModeSelection = 2
+	EMSModeSelectionExternalEMS         EMSModeSelection = 3
+)
+
+var emsModeSelectionLabels = map[int]string{
+	0: "Self-consumption mode",
+	2: "Forced mode",
+	3: "External EMS",
+}
+
+func (v EMSModeSelection) String() string {
+	return enum("EMSModeSelection", int(v), emsModeSelectionLabels)
+}
+
+// ExportPowerLimitation is an enumerated value.
+type ExportPowerLimitation uint16
+
+const (
+	ExportPowerLimitationDisable ExportPowerLimitation = 85
+	ExportPowerLimitationEnable  ExportPowerLimitation = 170
+)
+
+var exportPowerLimitationLabels = map[int]string{
+	85:  "disable",
+	170: "enable",
+}
+
+func (v ExportPowerLimitation) String() string {
+	return enum("ExportPowerLimitation", int(v), exportPowerLimitationLabels)
+}
+
+// ExportPowerLimitation13087 is an enumerated value.
+type ExportPowerLimitation13087 uint16
+
+const (
+	ExportPowerLimitation13087Disable ExportPowerLimitation13087 = 85
+	ExportPowerLimitation13087Enable  ExportPowerLimitation13087 = 170
+)
+
+var exportPowerLimitation13087Labels = map[int]string{
+	85:  "Disable",
+	170: "Enable",
+}
+
+func (v ExportPowerLimitation13087) String() string {
+	return enum("ExportPowerLimitation13087", int(v), exportPowerLimitation13087Labels)
+}
+
+// GridState is an enumerated value.
+type GridState uint16
+
+const (
+	GridStateOnGrid  GridState = 85
+	GridStateOffGrid GridState = 170
+)
+
+var gridStateLabels = map[int]string{
+	85:  "On grid",
+	170: "Off grid",
+}
+
+func (v GridState) String() string { return enum("GridState", int(v), gridStateLabels) }
+
+// Load1AdjustmentMode is an enumerated value.
+type Load1AdjustmentMode uint16
+
+const (
+	Load1AdjustmentModeTimingMode         Load1AdjustmentMode = 0
+	Load1AdjustmentModeONOFFMode          Load1AdjustmentMode = 1
+	Load1AdjustmentModePowerOptimizedMode Load1AdjustmentMode = 2
+)
+
+var load1AdjustmentModeLabels = map[int]string{
+	0: "Timing mode",
+	1: "ON/OFF mode",
+	2: "Power optimized mode",
+}
+
+func (v Load1AdjustmentMode) String() string {
+	return enum("Load1AdjustmentMode", int(v), load1AdjustmentModeLabels)
+}
+
+// Load1OnOffMode is an enumerated value.
+type Load1OnOffMode uint16
+
+const (
+	Load1OnOffModeOff Load1OnOffMode = 85
+	Load1OnOffModeOn  Load1OnOffMode = 170
+)
+
+var load1OnOffModeLabels = map[int]string{
+	85:  "Off",
+	170: "On",
+}
+
+func (v Load1OnOffMode) String() string { return enum("Load1OnOffMode", int(v), load1OnOffModeLabels) }
+
+// MeterCommDetection is an enumerated value.
+type MeterCommDetection uint16
+
+const (
+	MeterCommDetectionDisable MeterCommDetection = 85
+	MeterCommDetectionEnable  MeterCommDetection = 170
+)
+
+var meterCommDetectionLabels = map[int]string{
+	85:  "Disable",
+	170: "Enable",
+}
+
+func (v MeterCommDetection) String() string {
+	return enum("MeterCommDetection", int(v), meterCommDetectionLabels)
+}
+
+// OffGridOption is an enumerated value.
+type OffGridOption uint16
+
+const (
+	OffGridOptionDisable OffGridOption = 85
+	OffGridOptionEnable  OffGridOption = 170
+)
+
+var offGridOptionLabels = map[int]string{
+	85:  "Disable",
+	170: "Enable",
+}
+
+func (v OffGridOption) String() string { return enum("OffGridOption", int(v), offGridOptionLabels) }
+
+// OutputType is an enumerated value.
+type OutputType uint16
+
+const (
+	OutputTypeSinglePhase OutputType = 0
+	OutputTypeX3P4L       OutputType = 1
+	OutputTypeX3P3L       OutputType = 2
+)
+
+var outputTypeLabels = map[int]string{
+	0: "Single phase",
+	1: "3P4L",
+	2: "3P3L",
+}
+
+func (v OutputType) String() string { return enum("OutputType", int(v), outputTypeLabels) }
+
+// PIDAlarmCode is an enumerated value.
+type PIDAlarmCode uint16
+
+const (
+	PIDAlarmCodePIDResistanceAbnormal               PIDAlarmCode = 432
+	PIDAlarmCodePIDFunctionAbnormal                 PIDAlarmCode = 433
+	PIDAlarmCodePIDOvervoltageOvercurrentProtection PIDAlarmCode = 545
+)
+
+var pidAlarmCodeLabels = map[int]string{
+	432: "PID resistance abnormal",
+	433: "PID function abnormal",
+	545: "PID overvoltage/overcurrent protection",
+}
+
+func (v PIDAlarmCode) String() string { return enum("PIDAlarmCode", int(v), pidAlarmCodeLabels) }
+
+// PIDWorkState is an enumerated value.
+type PIDWorkState uint16
+
+const (
+	PIDWorkStatePIDRecoverOperation PIDWorkState = 2
+	PIDWorkStateAntiPIDOperation    PIDWorkState = 4
+	PIDWorkStatePIDAbnormity        PIDWorkState = 8
+)
+
+var pidWorkStateLabels = map[int]string{
+	2: "PID Recover Operation",
+	4: "Anti-PID Operation",
+	8: "PID Abnormity",
+}
+
+func (v PIDWorkState) String() string { return enum("PIDWorkState", int(v), pidWorkStateLabels) }
+
+// PowerLimitationSwitch is an enumerated value.
+type PowerLimitationSwitch uint16
+
+const (
+	PowerLimitationSwitchDisable PowerLimitationSwitch = 85
+	PowerLimitationSwitchEnable  PowerLimitationSwitch = 170
+)
+
+var powerLimitationSwitchLabels = map[int]string{
+	85:  "disable",
+	170: "enable",
+}
+
+func (v PowerLimitationSwitch) String() string {
+	return enum("PowerLimitationSwitch", int(v), powerLimitationSwitchLabels)
+}
+
+// RunningState is a set of flags.
+type RunningState uint16
+
+const (
+	RunningStatePowerGeneratedFromPV   RunningState = 0x1
+	RunningStateCharging               RunningState = 0x2
+	RunningStateDischarging            RunningState = 0x4
+	RunningStateLoadIsActive           RunningState = 0x8
+	RunningStatePowerFeedInTheGrid     RunningState = 0x10
+	RunningStateImportingPowerFromGrid RunningState = 0x20
+	RunningStateReserved               RunningState = 0x40
+	RunningStatePowerGenratedFromLoad  RunningState = 0x80
+)
+
+var runningStateLabels = map[int]string{
+	1:   "Power generated from PV",
+	2:   "Charging",
+	4:   "Discharging",
+	8:   "Load is active",
+	16:  "Power feed-in the grid",
+	32:  "Importing power from grid",
+	64:  "~reserved~",
+	128: "Power genrated from 'Load'",
+}
+
+// Has reports whether all the flags are set.
+func (v RunningState) Has(flags RunningState) bool { return v&flags == flags }
+
+// Flags returns the labels of the flags set, lowest first.
+func (v RunningState) Flags() []string { return flags(int(v), runningStateLabels) }
+
+func (v RunningState) String() string { return join(v.Flags()) }
+
+// StartStop is an enumerated value.
+type StartStop uint16
+
+const (
+	StartStopStop  StartStop = 206
+	StartStopStart StartStop = 207
+)
+
+var startStopLabels = map[int]string{
+	206: "stop",
+	207: "start",
+}
+
+func (v StartStop) String() string { return enum("StartStop", int(v), startStopLabels) }
+
+// SystemState is an enumerated value.
+type SystemState uint16
+
+const (
+	SystemStateStop                     SystemState = 2
+	SystemStateStandby                  SystemState = 8
+	SystemStateInitialStandby           SystemState = 16
+	SystemStateStartup                  SystemState = 32
+	SystemStateRunning                  SystemState = 64
+	SystemStateFault                    SystemState = 256
+	SystemStateRunningInMaintainMode    SystemState = 1024
+	SystemStateRunningInForcedMode      SystemState = 2048
+	SystemStateRunningInOffGridMode     SystemState = 4096
+	SystemStateRestarting               SystemState = 9473
+	SystemStateRunningInExternalEMSMode SystemState = 16384
+)
+
+var systemStateLabels = map[int]string{
+	2:     "Stop",
+	8:     "Standby",
+	16:    "Initial standby",
+	32:    "Startup",
+	64:    "Running",
+	256:   "Fault",
+	1024:  "Running in maintain mode",
+	2048:  "Running in forced mode",
+	4096:  "Running in off-grid mode",
+	9473:  "Restarting",
+	16384: "Running in External EMS mode",
+}
+
+func (v SystemState) String() string { return enum("SystemState", int(v), systemStateLabels) }
+
+// WorkState is an enumerated value.
+type WorkState uint16
+
+const (
+	WorkStateRun                WorkState = 0
+	WorkStateInitialStanby      WorkState = 4608
+	WorkStateKeyStop            WorkState = 4864
+	WorkStateStandby            WorkState = 5120
+	WorkStateEmergancyStop      WorkState = 5376
+	WorkStateStarting           WorkState = 5632
+	WorkStateCommunicationFault WorkState = 9472
+	WorkStateFault              WorkState = 21760
+	WorkStateStop               WorkState = 32768
+	WorkStateDeratingRun        WorkState = 33024
+	WorkStateDispatchRun        WorkState = 33280
+	WorkStateAlarmRun           WorkState = 37120
+)
+
+var workStateLabels = map[int]string{
+	0:     "Run",
+	4608:  "Initial Stanby",
+	4864:  "Key Stop",
+	5120:  "Standby",
+	5376:  "Emergancy Stop",
+	5632:  "Starting",
+	9472:  "Communication Fault",
+	21760: "Fault",
+	32768: "Stop",
+	33024: "Derating Run",
+	33280: "Dispatch Run",
+	37120: "Alarm Run",
+}
+
+func (v WorkState) String() string { return enum("WorkState", int(v), workStateLabels) }
+
+// WorkState5081 is an enumerated value.
+type WorkState5081 uint32
+
+const (
+	WorkState5081Run                WorkState5081 = 0
+	WorkState5081Stop               WorkState5081 = 1
+	WorkState5081InitialStanby      WorkState5081 = 2
+	WorkState5081KeyStop            WorkState5081 = 3
+	WorkState5081Standby            WorkState5081 = 4
+	WorkState5081EmergancyStop      WorkState5081 = 5
+	WorkState5081Starting           WorkState5081 = 6
+	WorkState5081Fault              WorkState5081 = 9
+	WorkState5081AlarmRun           WorkState5081 = 10
+	WorkState5081DeratingRun        WorkState5081 = 11
+	WorkState5081DispatchRun        WorkState5081 = 12
+	WorkState5081CommunicationFault WorkState5081 = 13
+	WorkState5081TotalRunBit        WorkState5081 = 17
+	WorkState5081TotalFaultBit      WorkState5081 = 18
+)
+
+var workState5081Labels = map[int]string{
+	0:  "Run",
+	1:  "Stop",
+	2:  "Initial Stanby",
+	3:  "Key Stop",
+	4:  "Standby",
+	5:  "Emergancy Stop",
+	6:  "Starting",
+	9:  "Fault",
+	10: "Alarm Run",
+	11: "Derating Run",
+	12: "Dispatch Run",
+	13: "Communication Fault",
+	17: "Total Run Bit",
+	18: "Total Fault Bit",
+}
+
+func (v WorkState5081) String() string { return enum("WorkState5081", int(v), workState5081Labels) }
+
+// LPP34KSG has the registers of the LP_P34KSG and SG60KU.
+type LPP34KSG struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of LPP34KSG.
+func (LPP34KSG) Models() []string {
+	return []string{"LP_P34KSG", "SG60KU"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *LPP34KSG) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG10KTL has the registers of the SG10KTL, SG30KTL, SG15KTL, SG12KTL, SG20KTL, SG30KU, SG36KTL, SG36KU, SG40KTL and SG34KJ.
+type SG10KTL struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG10KTL.
+func (SG10KTL) Models() []string {
+	return []string{"SG10KTL", "SG30KTL", "SG15KTL", "SG12KTL", "SG20KTL", "SG30KU", "SG36KTL", "SG36KU", "SG40KTL", "SG34KJ"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG10KTL) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG110CX has the registers of the SG110CX and SG75CX.
+type SG110CX struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	TotalAparentPower               uint32                `register:"total_aparent_power,input,5009" unit:"VA"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	MPPT3Voltage                    float64               `register:"mppt_3_voltage,input,5015" unit:"V"`
+	MPPT3Current                    float64               `register:"mppt_3_current,input,5016" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MPPT4Voltage                    float64               `register:"mppt_4_voltage,input,5115" unit:"V"`
+	MPPT4Current                    float64               `register:"mppt_4_current,input,5116" unit:"A"`
+	MPPT5Voltage                    float64               `register:"mppt_5_voltage,input,5117" unit:"V"`
+	MPPT5Current                    float64               `register:"mppt_5_current,input,5118" unit:"A"`
+	MPPT6Voltage                    float64               `register:"mppt_6_voltage,input,5119" unit:"V"`
+	MPPT6Current                    float64               `register:"mppt_6_current,input,5120" unit:"A"`
+	MPPT7Voltage                    float64               `register:"mppt_7_voltage,input,5121" unit:"V"`
+	MPPT7Current                    float64               `register:"mppt_7_current,input,5122" unit:"A"`
+	MPPT8Voltage                    float64               `register:"mppt_8_voltage,input,5123" unit:"V"`
+	MPPT8Current                    float64               `register:"mppt_8_current,input,5124" unit:"A"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	MPPT9Voltage                    float64               `register:"mppt_9_voltage,input,5130" unit:"V"`
+	MPPT9Current                    float64               `register:"mppt_9_current,input,5131" unit:"A"`
+	TotalPowerYields5144            float64               `register:"total_power_yields,input,5144" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	GridFrequency5148               float64               `register:"grid_frequency,input,5148" unit:"Hz"`
+	PIDWorkState                    PIDWorkState          `register:"pid_work_state,input,5150"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG110CX.
+func (SG110CX) Models() []string {
+	return []string{"SG110CX", "SG75CX"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG110CX) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5009, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalAparentPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5115, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5116, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Current = number(r) }},
+		{false, sungrow.Register{Address: 5117, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT5Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5118, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT5Current = number(r) }},
+		{false, sungrow.Register{Address: 5119, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT6Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5120, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT6Current = number(r) }},
+		{false, sungrow.Register{Address: 5121, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT7Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5122, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT7Current = number(r) }},
+		{false, sungrow.Register{Address: 5123, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT8Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5124, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT8Current = number(r) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5130, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT9Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5131, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT9Current = number(r) }},
+		{false, sungrow.Register{Address: 5144, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields5144 = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5148, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.GridFrequency5148 = number(r) }},
+		{false, sungrow.Register{Address: 5150, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDWorkState = PIDWorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG125HV has the registers of the SG125HV, SG111HV and SG125HV-20.
+type SG125HV struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	TotalAparentPower               uint32                `register:"total_aparent_power,input,5009" unit:"VA"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	TotalPowerYields5144            float64               `register:"total_power_yields,input,5144" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	GridFrequency5148               float64               `register:"grid_frequency,input,5148" unit:"Hz"`
+	PIDWorkState                    PIDWorkState          `register:"pid_work_state,input,5150"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG125HV.
+func (SG125HV) Models() []string {
+	return []string{"SG125HV", "SG111HV", "SG125HV-20"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG125HV) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5009, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalAparentPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5144, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields5144 = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5148, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.GridFrequency5148 = number(r) }},
+		{false, sungrow.Register{Address: 5150, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDWorkState = PIDWorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG12KTLM has the registers of the SG12KTL-M, SG10KTL-M, SG8KTL-M, SG15KTL-M, SG20KTL-M, SG6KTL-MT, SG17KTL-M and SG10KTL-MT.
+type SG12KTLM struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	TotalAparentPower               uint32                `register:"total_aparent_power,input,5009" unit:"VA"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	MeterPower                      int32                 `register:"meter_power,input,5083" unit:"W"`
+	MeterAPhasePower                int32                 `register:"meter_a_phase_power,input,5085" unit:"W"`
+	MeterBPhasePower                int32                 `register:"meter_b_phase_power,input,5087" unit:"W"`
+	MeterCPhasePower                int32                 `register:"meter_c_phase_power,input,5089" unit:"W"`
+	LoadPower                       int32                 `register:"load_power,input,5091" unit:"W"`
+	DailyExportEnergy               float64               `register:"daily_export_energy,input,5093" unit:"kWh"`
+	TotalExportEnergy               float64               `register:"total_export_energy,input,5095" unit:"kWh"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,5097" unit:"kWh"`
+	TotalImportEnergy               float64               `register:"total_import_energy,input,5099" unit:"kWh"`
+	DailyDirectEnergyConsumption    float64               `register:"daily_direct_energy_consumption,input,5101" unit:"kWh"`
+	TotalDirectEnergyConsumption    float64               `register:"total_direct_energy_consumption,input,5103" unit:"kWh"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	TotalPowerYields5144            float64               `register:"total_power_yields,input,5144" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	GridFrequency5148               float64               `register:"grid_frequency,input,5148" unit:"Hz"`
+	PIDWorkState                    PIDWorkState          `register:"pid_work_state,input,5150"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy13036          float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG12KTLM.
+func (SG12KTLM) Models() []string {
+	return []string{"SG12KTL-M", "SG10KTL-M", "SG8KTL-M", "SG15KTL-M", "SG20KTL-M", "SG6KTL-MT", "SG17KTL-M", "SG10KTL-MT"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG12KTLM) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5009, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalAparentPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5083, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5085, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterAPhasePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5087, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterBPhasePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5089, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterCPhasePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5091, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.LoadPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5093, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 5095, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 5097, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 5099, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 5101, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 5103, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5144, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields5144 = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5148, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.GridFrequency5148 = number(r) }},
+		{false, sungrow.Register{Address: 5150, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDWorkState = PIDWorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy13036 = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG250HX has the registers of the SG250HX, SG250HX-US, SG100CX and SG250HX-IN.
+type SG250HX struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	TotalAparentPower               uint32                `register:"total_aparent_power,input,5009" unit:"VA"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	MPPT3Voltage                    float64               `register:"mppt_3_voltage,input,5015" unit:"V"`
+	MPPT3Current                    float64               `register:"mppt_3_current,input,5016" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MPPT4Voltage                    float64               `register:"mppt_4_voltage,input,5115" unit:"V"`
+	MPPT4Current                    float64               `register:"mppt_4_current,input,5116" unit:"A"`
+	MPPT5Voltage                    float64               `register:"mppt_5_voltage,input,5117" unit:"V"`
+	MPPT5Current                    float64               `register:"mppt_5_current,input,5118" unit:"A"`
+	MPPT6Voltage                    float64               `register:"mppt_6_voltage,input,5119" unit:"V"`
+	MPPT6Current                    float64               `register:"mppt_6_current,input,5120" unit:"A"`
+	MPPT7Voltage                    float64               `register:"mppt_7_voltage,input,5121" unit:"V"`
+	MPPT7Current                    float64               `register:"mppt_7_current,input,5122" unit:"A"`
+	MPPT8Voltage                    float64               `register:"mppt_8_voltage,input,5123" unit:"V"`
+	MPPT8Current                    float64               `register:"mppt_8_current,input,5124" unit:"A"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	MPPT9Voltage                    float64               `register:"mppt_9_voltage,input,5130" unit:"V"`
+	MPPT9Current                    float64               `register:"mppt_9_current,input,5131" unit:"A"`
+	MPPT10Voltage                   float64               `register:"mppt_10_voltage,input,5132" unit:"V"`
+	MPPT10Current                   float64               `register:"mppt_10_current,input,5133" unit:"A"`
+	MPPT11Voltage                   float64               `register:"mppt_11_voltage,input,5134" unit:"V"`
+	MPPT11Current                   float64               `register:"mppt_11_current,input,5135" unit:"A"`
+	MPPT12Voltage                   float64               `register:"mppt_12_voltage,input,5136" unit:"V"`
+	MPPT12Current                   float64               `register:"mppt_12_current,input,5137" unit:"A"`
+	TotalPowerYields5144            float64               `register:"total_power_yields,input,5144" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	GridFrequency5148               float64               `register:"grid_frequency,input,5148" unit:"Hz"`
+	PIDWorkState                    PIDWorkState          `register:"pid_work_state,input,5150"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG250HX.
+func (SG250HX) Models() []string {
+	return []string{"SG250HX", "SG250HX-US", "SG100CX", "SG250HX-IN"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG250HX) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5009, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalAparentPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5115, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5116, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Current = number(r) }},
+		{false, sungrow.Register{Address: 5117, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT5Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5118, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT5Current = number(r) }},
+		{false, sungrow.Register{Address: 5119, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT6Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5120, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT6Current = number(r) }},
+		{false, sungrow.Register{Address: 5121, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT7Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5122, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT7Current = number(r) }},
+		{false, sungrow.Register{Address: 5123, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT8Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5124, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT8Current = number(r) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5130, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT9Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5131, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT9Current = number(r) }},
+		{false, sungrow.Register{Address: 5132, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT10Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5133, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT10Current = number(r) }},
+		{false, sungrow.Register{Address: 5134, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT11Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5135, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT11Current = number(r) }},
+		{false, sungrow.Register{Address: 5136, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT12Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5137, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT12Current = number(r) }},
+		{false, sungrow.Register{Address: 5144, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields5144 = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5148, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.GridFrequency5148 = number(r) }},
+		{false, sungrow.Register{Address: 5150, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDWorkState = PIDWorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG30KTLMV31 has the registers of the SG30KTL-M-V31, SG33KTL-M and SG30KTL-M.
+type SG30KTLMV31 struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	MPPT3Voltage                    float64               `register:"mppt_3_voltage,input,5015" unit:"V"`
+	MPPT3Current                    float64               `register:"mppt_3_current,input,5016" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG30KTLMV31.
+func (SG30KTLMV31) Models() []string {
+	return []string{"SG30KTL-M-V31", "SG33KTL-M", "SG30KTL-M"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG30KTLMV31) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG33CX has the registers of the SG33CX, SG36CX-US, SG30CX and SG25CX-SA.
+type SG33CX struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	TotalAparentPower               uint32                `register:"total_aparent_power,input,5009" unit:"VA"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	MPPT3Voltage                    float64               `register:"mppt_3_voltage,input,5015" unit:"V"`
+	MPPT3Current                    float64               `register:"mppt_3_current,input,5016" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	TotalPowerYields5144            float64               `register:"total_power_yields,input,5144" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	GridFrequency5148               float64               `register:"grid_frequency,input,5148" unit:"Hz"`
+	PIDWorkState                    PIDWorkState          `register:"pid_work_state,input,5150"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG33CX.
+func (SG33CX) Models() []string {
+	return []string{"SG33CX", "SG36CX-US", "SG30CX", "SG25CX-SA"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG33CX) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5009, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalAparentPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5144, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields5144 = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5148, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.GridFrequency5148 = number(r) }},
+		{false, sungrow.Register{Address: 5150, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDWorkState = PIDWorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG36KTLM has the registers of the SG36KTL-M, SG40KTL-M and SG33K3J.
+type SG36KTLM struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	TotalAparentPower               uint32                `register:"total_aparent_power,input,5009" unit:"VA"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	MPPT3Voltage                    float64               `register:"mppt_3_voltage,input,5015" unit:"V"`
+	MPPT3Current                    float64               `register:"mppt_3_current,input,5016" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG36KTLM.
+func (SG36KTLM) Models() []string {
+	return []string{"SG36KTL-M", "SG40KTL-M", "SG33K3J"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG36KTLM) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5009, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalAparentPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG49K5J has the registers of the SG49K5J.
+type SG49K5J struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	MPPT3Voltage                    float64               `register:"mppt_3_voltage,input,5015" unit:"V"`
+	MPPT3Current                    float64               `register:"mppt_3_current,input,5016" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MPPT4Voltage                    float64               `register:"mppt_4_voltage,input,5115" unit:"V"`
+	MPPT4Current                    float64               `register:"mppt_4_current,input,5116" unit:"A"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG49K5J.
+func (SG49K5J) Models() []string {
+	return []string{"SG49K5J"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG49K5J) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5115, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5116, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Current = number(r) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG50CX has the registers of the SG50CX and SG60CX-US.
+type SG50CX struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	TotalAparentPower               uint32                `register:"total_aparent_power,input,5009" unit:"VA"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	MPPT3Voltage                    float64               `register:"mppt_3_voltage,input,5015" unit:"V"`
+	MPPT3Current                    float64               `register:"mppt_3_current,input,5016" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MPPT4Voltage                    float64               `register:"mppt_4_voltage,input,5115" unit:"V"`
+	MPPT4Current                    float64               `register:"mppt_4_current,input,5116" unit:"A"`
+	MPPT5Voltage                    float64               `register:"mppt_5_voltage,input,5117" unit:"V"`
+	MPPT5Current                    float64               `register:"mppt_5_current,input,5118" unit:"A"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	TotalPowerYields5144            float64               `register:"total_power_yields,input,5144" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	GridFrequency5148               float64               `register:"grid_frequency,input,5148" unit:"Hz"`
+	PIDWorkState                    PIDWorkState          `register:"pid_work_state,input,5150"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG50CX.
+func (SG50CX) Models() []string {
+	return []string{"SG50CX", "SG60CX-US"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG50CX) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5009, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalAparentPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5115, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5116, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Current = number(r) }},
+		{false, sungrow.Register{Address: 5117, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT5Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5118, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT5Current = number(r) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5144, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields5144 = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5148, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.GridFrequency5148 = number(r) }},
+		{false, sungrow.Register{Address: 5150, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDWorkState = PIDWorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG50KTLM has the registers of the SG50KTL-M, SG60KTL-M and SG60KU-M.
+type SG50KTLM struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	TotalAparentPower               uint32                `register:"total_aparent_power,input,5009" unit:"VA"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	MPPT3Voltage                    float64               `register:"mppt_3_voltage,input,5015" unit:"V"`
+	MPPT3Current                    float64               `register:"mppt_3_current,input,5016" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MPPT4Voltage                    float64               `register:"mppt_4_voltage,input,5115" unit:"V"`
+	MPPT4Current                    float64               `register:"mppt_4_current,input,5116" unit:"A"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG50KTLM.
+func (SG50KTLM) Models() []string {
+	return []string{"SG50KTL-M", "SG60KTL-M", "SG60KU-M"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG50KTLM) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5009, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalAparentPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5115, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5116, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Current = number(r) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG5KTLMT has the registers of the SG5KTL-MT.
+type SG5KTLMT struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	MeterPower                      int32                 `register:"meter_power,input,5083" unit:"W"`
+	MeterAPhasePower                int32                 `register:"meter_a_phase_power,input,5085" unit:"W"`
+	MeterBPhasePower                int32                 `register:"meter_b_phase_power,input,5087" unit:"W"`
+	MeterCPhasePower                int32                 `register:"meter_c_phase_power,input,5089" unit:"W"`
+	LoadPower                       int32                 `register:"load_power,input,5091" unit:"W"`
+	DailyExportEnergy               float64               `register:"daily_export_energy,input,5093" unit:"kWh"`
+	TotalExportEnergy               float64               `register:"total_export_energy,input,5095" unit:"kWh"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,5097" unit:"kWh"`
+	TotalImportEnergy               float64               `register:"total_import_energy,input,5099" unit:"kWh"`
+	DailyDirectEnergyConsumption    float64               `register:"daily_direct_energy_consumption,input,5101" unit:"kWh"`
+	TotalDirectEnergyConsumption    float64               `register:"total_direct_energy_consumption,input,5103" unit:"kWh"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	TotalPowerYields5144            float64               `register:"total_power_yields,input,5144" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	GridFrequency5148               float64               `register:"grid_frequency,input,5148" unit:"Hz"`
+	PIDWorkState                    PIDWorkState          `register:"pid_work_state,input,5150"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy13036          float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG5KTLMT.
+func (SG5KTLMT) Models() []string {
+	return []string{"SG5KTL-MT"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG5KTLMT) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5083, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5085, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterAPhasePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5087, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterBPhasePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5089, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterCPhasePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5091, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.LoadPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5093, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 5095, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 5097, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 5099, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 5101, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 5103, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5144, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields5144 = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5148, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.GridFrequency5148 = number(r) }},
+		{false, sungrow.Register{Address: 5150, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDWorkState = PIDWorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy13036 = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG5d0RT has the registers of the SG5.0RT, SG6.0RT, SG8.0RT, SG10RT, SG12RT, SG15RT, SG17RT, SG20RT, SG7.0RT, SG3.0RT and SG4.0RT.
+type SG5d0RT struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	TotalAparentPower               uint32                `register:"total_aparent_power,input,5009" unit:"VA"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	TotalPowerYields5144            float64               `register:"total_power_yields,input,5144" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	GridFrequency5148               float64               `register:"grid_frequency,input,5148" unit:"Hz"`
+	PIDWorkState                    PIDWorkState          `register:"pid_work_state,input,5150"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG5d0RT.
+func (SG5d0RT) Models() []string {
+	return []string{"SG5.0RT", "SG6.0RT", "SG8.0RT", "SG10RT", "SG12RT", "SG15RT", "SG17RT", "SG20RT", "SG7.0RT", "SG3.0RT", "SG4.0RT"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG5d0RT) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5009, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalAparentPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5144, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields5144 = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5148, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.GridFrequency5148 = number(r) }},
+		{false, sungrow.Register{Address: 5150, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDWorkState = PIDWorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG60KTL has the registers of the SG60KTL and SG80KTL.
+type SG60KTL struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	TotalAparentPower               uint32                `register:"total_aparent_power,input,5009" unit:"VA"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG60KTL.
+func (SG60KTL) Models() []string {
+	return []string{"SG60KTL", "SG80KTL"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG60KTL) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5009, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalAparentPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SG80KTLM has the registers of the SG80KTL-M and SG40CX.
+type SG80KTLM struct {
+	ProtocolNo                      uint32                `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode        `register:"device_type_code,input,5000"`
+	NominalActivePower              float64               `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType            `register:"output_type,input,5002"`
+	DailyPowerYields                float64               `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                `register:"total_power_yields,input,5004" unit:"kWh"`
+	TotalRunningTime                uint32                `register:"total_running_time,input,5006" unit:"h"`
+	InternalTemperature             float64               `register:"internal_temperature,input,5008" unit:"°C"`
+	TotalAparentPower               uint32                `register:"total_aparent_power,input,5009" unit:"VA"`
+	MPPT1Voltage                    float64               `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64               `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64               `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64               `register:"mppt_2_current,input,5014" unit:"A"`
+	MPPT3Voltage                    float64               `register:"mppt_3_voltage,input,5015" unit:"V"`
+	MPPT3Current                    float64               `register:"mppt_3_current,input,5016" unit:"A"`
+	TotalDCPower                    uint32                `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64               `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64               `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64               `register:"voltage_c_phase,input,5021" unit:"V"`
+	CurrentAPhase                   float64               `register:"current_a_phase,input,5022" unit:"A"`
+	CurrentBPhase                   float64               `register:"current_b_phase,input,5023" unit:"A"`
+	CurrentCPhase                   float64               `register:"current_c_phase,input,5024" unit:"A"`
+	TotalActivePower                uint32                `register:"total_active_power,input,5031" unit:"W"`
+	TotalReactivePower              int32                 `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64               `register:"power_factor,input,5035"`
+	GridFrequency                   float64               `register:"grid_frequency,input,5036" unit:"Hz"`
+	WorkState                       WorkState             `register:"work_state,input,5038"`
+	FaultYear                       uint16                `register:"fault_year,input,5039"`
+	FaultMonth                      uint16                `register:"fault_month,input,5040"`
+	FaultDay                        uint16                `register:"fault_day,input,5041"`
+	FaultHour                       uint16                `register:"fault_hour,input,5042"`
+	FaultMinute                     uint16                `register:"fault_minute,input,5043"`
+	FaultSecond                     uint16                `register:"fault_second,input,5044"`
+	FaultCode                       uint16                `register:"fault_code,input,5045"`
+	NominalReactivePower            float64               `register:"nominal_reactive_power,input,5049" unit:"kVar"`
+	ArrayInsulationResistance       uint16                `register:"array_insulation_resistance,input,5071" unit:"kΩ"`
+	ActivePowerRegulationSetpoint   uint32                `register:"active_power_regulation_setpoint,input,5077" unit:"w"`
+	ReactivePowerRegualtionSetpoint int32                 `register:"reactive_power_regualtion_setpoint,input,5079" unit:"Var"`
+	WorkState5081                   WorkState5081         `register:"work_state,input,5081"`
+	DailyRunningTime                uint16                `register:"daily_running_time,input,5113" unit:"min"`
+	PresentCountry                  uint16                `register:"present_country,input,5114"`
+	MPPT4Voltage                    float64               `register:"mppt_4_voltage,input,5115" unit:"V"`
+	MPPT4Current                    float64               `register:"mppt_4_current,input,5116" unit:"A"`
+	MonthlyPowerYields              float64               `register:"monthly_power_yields,input,5128" unit:"kWh"`
+	TotalPowerYields5144            float64               `register:"total_power_yields,input,5144" unit:"kWh"`
+	NetagiveVoltageToGround         float64               `register:"netagive_voltage_to_ground,input,5146" unit:"V"`
+	BusVoltage                      float64               `register:"bus_voltage,input,5147" unit:"V"`
+	GridFrequency5148               float64               `register:"grid_frequency,input,5148" unit:"Hz"`
+	PIDWorkState                    PIDWorkState          `register:"pid_work_state,input,5150"`
+	PIDAlarmCode                    PIDAlarmCode          `register:"pid_alarm_code,input,5151"`
+	ExportPower                     uint16                `register:"export_power,input,5216"`
+	PowerMeter                      uint16                `register:"power_meter,input,5218"`
+	DirectPowerConsumptionYearlyPV  float64               `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	String1Current                  float64               `register:"string_1_current,input,7013" unit:"A"`
+	String2Current                  float64               `register:"string_2_current,input,7014" unit:"A"`
+	String3Current                  float64               `register:"string_3_current,input,7015" unit:"A"`
+	String4Current                  float64               `register:"string_4_current,input,7016" unit:"A"`
+	String5Current                  float64               `register:"string_5_current,input,7017" unit:"A"`
+	String6Current                  float64               `register:"string_6_current,input,7018" unit:"A"`
+	String7Current                  float64               `register:"string_7_current,input,7019" unit:"A"`
+	String8Current                  float64               `register:"string_8_current,input,7020" unit:"A"`
+	String9Current                  float64               `register:"string_9_current,input,7021" unit:"A"`
+	String10Current                 float64               `register:"string_10_current,input,7022" unit:"A"`
+	String11Current                 float64               `register:"string_11_current,input,7023" unit:"A"`
+	String12Current                 float64               `register:"string_12_current,input,7024" unit:"A"`
+	String13Current                 float64               `register:"string_13_current,input,7025" unit:"A"`
+	String14Current                 float64               `register:"string_14_current,input,7026" unit:"A"`
+	String15Current                 float64               `register:"string_15_current,input,7027" unit:"A"`
+	String16Current                 float64               `register:"string_16_current,input,7028" unit:"A"`
+	String17Current                 float64               `register:"string_17_current,input,7029" unit:"A"`
+	String18Current                 float64               `register:"string_18_current,input,7030" unit:"A"`
+	String19Current                 float64               `register:"string_19_current,input,7031" unit:"A"`
+	String20Current                 float64               `register:"string_20_current,input,7032" unit:"A"`
+	String21Current                 float64               `register:"string_21_current,input,7033" unit:"A"`
+	String22Current                 float64               `register:"string_22_current,input,7034" unit:"A"`
+	String23Current                 float64               `register:"string_23_current,input,7035" unit:"A"`
+	String24Current                 float64               `register:"string_24_current,input,7036" unit:"A"`
+	DailyImportEnergy               float64               `register:"daily_import_energy,input,13036" unit:"kWh"`
+	SystemClockYear                 uint16                `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop             `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64               `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer    `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64               `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64               `register:"installed_pv_power,holding,5016" unit:"kW"`
+}
+
+// Models returns the models with the registers of SG80KTLM.
+func (SG80KTLM) Models() []string {
+	return []string{"SG80KTL-M", "SG40CX"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SG80KTLM) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5006, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalRunningTime = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5009, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalAparentPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT3Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5022, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.CurrentCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5031, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5038, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState = WorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5039, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultYear = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5040, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMonth = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5041, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultDay = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5042, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultHour = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultMinute = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5044, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultSecond = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5045, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.FaultCode = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5049, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalReactivePower = number(r) }},
+		{false, sungrow.Register{Address: 5071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ArrayInsulationResistance = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5077, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ActivePowerRegulationSetpoint = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5079, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReactivePowerRegualtionSetpoint = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5081, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.WorkState5081 = WorkState5081(number(r)) }},
+		{false, sungrow.Register{Address: 5113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DailyRunningTime = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PresentCountry = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5115, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5116, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT4Current = number(r) }},
+		{false, sungrow.Register{Address: 5128, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MonthlyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5144, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields5144 = number(r) }},
+		{false, sungrow.Register{Address: 5146, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NetagiveVoltageToGround = number(r) }},
+		{false, sungrow.Register{Address: 5147, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BusVoltage = number(r) }},
+		{false, sungrow.Register{Address: 5148, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.GridFrequency5148 = number(r) }},
+		{false, sungrow.Register{Address: 5150, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDWorkState = PIDWorkState(number(r)) }},
+		{false, sungrow.Register{Address: 5151, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PIDAlarmCode = PIDAlarmCode(number(r)) }},
+		{false, sungrow.Register{Address: 5216, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5218, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerMeter = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 7013, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String1Current = number(r) }},
+		{false, sungrow.Register{Address: 7014, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String2Current = number(r) }},
+		{false, sungrow.Register{Address: 7015, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String3Current = number(r) }},
+		{false, sungrow.Register{Address: 7016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String4Current = number(r) }},
+		{false, sungrow.Register{Address: 7017, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String5Current = number(r) }},
+		{false, sungrow.Register{Address: 7018, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String6Current = number(r) }},
+		{false, sungrow.Register{Address: 7019, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String7Current = number(r) }},
+		{false, sungrow.Register{Address: 7020, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String8Current = number(r) }},
+		{false, sungrow.Register{Address: 7021, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String9Current = number(r) }},
+		{false, sungrow.Register{Address: 7022, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String10Current = number(r) }},
+		{false, sungrow.Register{Address: 7023, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String11Current = number(r) }},
+		{false, sungrow.Register{Address: 7024, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String12Current = number(r) }},
+		{false, sungrow.Register{Address: 7025, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String13Current = number(r) }},
+		{false, sungrow.Register{Address: 7026, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String14Current = number(r) }},
+		{false, sungrow.Register{Address: 7027, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String15Current = number(r) }},
+		{false, sungrow.Register{Address: 7028, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String16Current = number(r) }},
+		{false, sungrow.Register{Address: 7029, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String17Current = number(r) }},
+		{false, sungrow.Register{Address: 7030, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String18Current = number(r) }},
+		{false, sungrow.Register{Address: 7031, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String19Current = number(r) }},
+		{false, sungrow.Register{Address: 7032, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String20Current = number(r) }},
+		{false, sungrow.Register{Address: 7033, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String21Current = number(r) }},
+		{false, sungrow.Register{Address: 7034, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String22Current = number(r) }},
+		{false, sungrow.Register{Address: 7035, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String23Current = number(r) }},
+		{false, sungrow.Register{Address: 7036, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.String24Current = number(r) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+	})
+}
+
+// SH10RT has the registers of the SH10RT.
+type SH10RT struct {
+	ProtocolNo                      uint32                     `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                     `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                     `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                     `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                     `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode             `register:"device_type_code,input,5000"`
+	NominalActivePower              float64                    `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType                 `register:"output_type,input,5002"`
+	DailyPowerYields                float64                    `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                     `register:"total_power_yields,input,5004" unit:"kWh"`
+	InternalTemperature             float64                    `register:"internal_temperature,input,5008" unit:"°C"`
+	MPPT1Voltage                    float64                    `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64                    `register:"mppt_1_current,input,5012" unit:"A"`
+	MPPT2Voltage                    float64                    `register:"mppt_2_voltage,input,5013" unit:"V"`
+	MPPT2Current                    float64                    `register:"mppt_2_current,input,5014" unit:"A"`
+	TotalDCPower                    uint32                     `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64                    `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64                    `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64                    `register:"voltage_c_phase,input,5021" unit:"V"`
+	TotalReactivePower              int32                      `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64                    `register:"power_factor,input,5035"`
+	GridFrequency                   float64                    `register:"grid_frequency,input,5036" unit:"Hz"`
+	ExportLimitMin                  float64                    `register:"export_limit_min,input,5622" unit:"W"`
+	ExportLimitMax                  float64                    `register:"export_limit_max,input,5623" unit:"W"`
+	BdcRatedPower                   float64                    `register:"bdc_rated_power,input,5628" unit:"W"`
+	MaxChargingCurrentBMS           uint16                     `register:"max_charging_current_bms,input,5635" unit:"A"`
+	MaxDischargingCurrentBMS        uint16                     `register:"max_discharging_current_bms,input,5636" unit:"A"`
+	PVPowerOfToday                  uint16                     `register:"pv_power_of_today,input,6100" unit:"W"`
+	DailyPVEnergyYields             float64                    `register:"daily_pv_energy_yields,input,6196" unit:"kWh"`
+	MonthlyPVEnergyYields           uint16                     `register:"monthly_pv_energy_yields,input,6227" unit:"kWh"`
+	YearlyPVEnergyYields            float64                    `register:"yearly_pv_energy_yields,input,6250" unit:"kWh"`
+	DirectPowerConsumptionTodayPV   uint16                     `register:"direct_power_consumption_today_pv,input,6290" unit:"W"`
+	DirectPowerConsumptionPV        float64                    `register:"direct_power_consumption_pv,input,6386" unit:"kWh"`
+	DirectPowerConsumptionMonthlyPV float64                    `register:"direct_power_consumption_monthly_pv,input,6417" unit:"kWh"`
+	DirectPowerConsumptionYearlyPV  float64                    `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	ExportPowerFromPVToday          uint16                     `register:"export_power_from_pv_today,input,6469" unit:"W"`
+	ExportPowerFromPV               float64                    `register:"export_power_from_pv,input,6565" unit:"kWh"`
+	ExportPowerFromPVMonthly        float64                    `register:"export_power_from_pv_monthly,input,6596" unit:"kWh"`
+	ExportPowerFromPVYearly         float64                    `register:"export_power_from_pv_yearly,input,6608" unit:"kWh"`
+	BatteryChargePowerFromPVToday   uint16                     `register:"battery_charge_power_from_pv_today,input,6648" unit:"W"`
+	BatteryChargePowerFromPV        float64                    `register:"battery_charge_power_from_pv,input,6744" unit:"kWh"`
+	BatteryChargePowerFromPVMonthly float64                    `register:"battery_charge_power_from_pv_monthly,input,6775" unit:"kWh"`
+	BatteryChargePowerFromPVYearly  float64                    `register:"battery_charge_power_from_pv_yearly,input,6787" unit:"kWh"`
+	SystemState                     SystemState                `register:"system_state,input,13000"`
+	RunningState                    RunningState               `register:"running_state,input,13001"`
+	DailyPVGeneration               float64                    `register:"daily_pv_generation,input,13002" unit:"kWh"`
+	TotalPVGeneration               float64                    `register:"total_pv_generation,input,13003" unit:"kWh"`
+	DailyExportFromPV               float64                    `register:"daily_export_from_pv,input,13005" unit:"kWh"`
+	TotalExportFromPV               float64                    `register:"total_export_from_pv,input,13006" unit:"kWh"`
+	LoadPower                       int32                      `register:"load_power,input,13008" unit:"W"`
+	ExportPower                     int32                      `register:"export_power,input,13010" unit:"W"`
+	DailyBatteryChargeFromPV        float64                    `register:"daily_battery_charge_from_pv,input,13012" unit:"kWh"`
+	TotalBatteryChargeFromPV        float64                    `register:"total_battery_charge_from_pv,input,13013" unit:"kWh"`
+	Co2Reduction                    float64                    `register:"co2_reduction,input,13015" unit:"kg"`
+	DailyDirectEnergyConsumption    float64                    `register:"daily_direct_energy_consumption,input,13017" unit:"kWh"`
+	TotalDirectEnergyConsumption    float64                    `register:"total_direct_energy_consumption,input,13018" unit:"kWh"`
+	BatteryVoltage                  float64                    `register:"battery_voltage,input,13020" unit:"V"`
+	BatteryCurrent                  float64                    `register:"battery_current,input,13021" unit:"A"`
+	BatteryPower                    uint16                     `register:"battery_power,input,13022" unit:"W"`
+	BatteryLevel                    float64                    `register:"battery_level,input,13023" unit:"%"`
+	BatteryHealth                   float64                    `register:"battery_health,input,13024" unit:"%"`
+	BatteryTemperature              float64                    `register:"battery_temperature,input,13025" unit:"°C"`
+	DailyBatteryDischargeEnergy     float64                    `register:"daily_battery_discharge_energy,input,13026" unit:"kWh"`
+	TotalBatteryDischargeEnergy     float64                    `register:"total_battery_discharge_energy,input,13027" unit:"kWh"`
+	SelfConsumptionToday            float64                    `register:"self_consumption_today,input,13029" unit:"%"`
+	GridState                       GridState                  `register:"grid_state,input,13030"`
+	PhaseACurrent                   float64                    `register:"phase_a_current,input,13031" unit:"A"`
+	PhaseBCurrent                   float64                    `register:"phase_b_current,input,13032" unit:"A"`
+	PhaseCCurrent                   float64                    `register:"phase_c_current,input,13033" unit:"A"`
+	TotalActivePower                int32                      `register:"total_active_power,input,13034" unit:"W"`
+	DailyImportEnergy               float64                    `register:"daily_import_energy,input,13036" unit:"kWh"`
+	TotalImportEnergy               float64                    `register:"total_import_energy,input,13037" unit:"kWh"`
+	DailyChargeEnergy               float64                    `register:"daily_charge_energy,input,13040" unit:"kWh"`
+	TotalChargeEnergy               float64                    `register:"total_charge_energy,input,13041" unit:"kWh"`
+	DrmState                        uint16                     `register:"drm_state,input,13043"`
+	DailyExportEnergy               float64                    `register:"daily_export_energy,input,13045" unit:"kWh"`
+	TotalExportEnergy               float64                    `register:"total_export_energy,input,13046" unit:"kWh"`
+	InverterAlarm                   uint32                     `register:"inverter_alarm,input,13050"`
+	GridSideFault                   uint32                     `register:"grid_side_fault,input,13052"`
+	SystemFault1                    uint32                     `register:"system_fault_1,input,13054"`
+	SystemFault2                    uint32                     `register:"system_fault_2,input,13056"`
+	DCSideFault                     uint32                     `register:"dc_side_fault,input,13058"`
+	PermanentFault                  uint32                     `register:"permanent_fault,input,13060"`
+	BdcSideFault                    uint32                     `register:"bdc_side_fault,input,13062"`
+	BdcSidePermanentFault           uint32                     `register:"bdc_side_permanent_fault,input,13064"`
+	BatteryFault                    uint32                     `register:"battery_fault,input,13066"`
+	BatteryAlarm                    uint32                     `register:"battery_alarm,input,13068"`
+	BMSAlarm                        uint32                     `register:"bms_alarm,input,13070"`
+	BMSProtection                   uint32                     `register:"bms_protection,input,13072"`
+	BMSFault1                       uint32                     `register:"bms_fault_1,input,13074"`
+	BMSFault2                       uint32                     `register:"bms_fault_2,input,13076"`
+	BMSAlarm2                       uint32                     `register:"bms_alarm_2,input,13078"`
+	SystemClockYear                 uint16                     `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                     `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                     `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                     `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                     `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                     `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop                  `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch      `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64                    `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation      `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                     `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                     `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                     `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer         `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64                    `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64                    `register:"installed_pv_power,holding,5016" unit:"kW"`
+	StartStop13000                  StartStop                  `register:"start_stop,holding,13000"`
+	Load1AdjustmentMode             Load1AdjustmentMode        `register:"load_1_adjustment_mode,holding,13002"`
+	Load1TimingPeriod1StartHour     uint16                     `register:"load_1_timing_period_1_start_hour,holding,13003"`
+	Load1TimingPeriod1StartMinute   uint16                     `register:"load_1_timing_period_1_start_minute,holding,13004"`
+	Load1TimingPeriod1EndHour       uint16                     `register:"load_1_timing_period_1_end_hour,holding,13005"`
+	Load1TimingPeriod1EndMinute     uint16                     `register:"load_1_timing_period_1_end_minute,holding,13006"`
+	Load1TimingPeriod2StartHour     uint16                     `register:"load_1_timing_period_2_start_hour,holding,13007"`
+	Load1TimingPeriod2StartMinute   uint16                     `register:"load_1_timing_period_2_start_minute,holding,13008"`
+	Load1TimingPeriod2EndHour       uint16                     `register:"load_1_timing_period_2_end_hour,holding,13009"`
+	Load1TimingPeriod2EndMinute     uint16                     `register:"load_1_timing_period_2_end_minute,holding,13010"`
+	Load1OnOffMode                  Load1OnOffMode             `register:"load_1_on_off_mode,holding,13011"`
+	Load1OptimizedModeStartHour     uint16                     `register:"load_1_optimized_mode_start_hour,holding,13012"`
+	Load1OptimizedModeStartMinute   uint16                     `register:"load_1_optimized_mode_start_minute,holding,13013"`
+	Load1OptimizedModeEndHour       uint16                     `register:"load_1_optimized_mode_end_hour,holding,13014"`
+	Load1OptimizedModeEndMinute     uint16                     `register:"load_1_optimized_mode_end_minute,holding,13015"`
+	Load1OptimizedPower             uint16                     `register:"load_1_optimized_power,holding,13016" unit:"W"`
+	EMSModeSelection                EMSModeSelection           `register:"ems_mode_selection,holding,13050"`
+	ChargeDischargeCommand          ChargeDischargeCommand     `register:"charge_discharge_command,holding,13051"`
+	ChargeDischargePower            uint16                     `register:"charge_discharge_power,holding,13052" unit:"W"`
+	MaxSOC                          float64                    `register:"max_soc,holding,13058" unit:"%"`
+	MinSOC                          float64                    `register:"min_soc,holding,13059" unit:"%"`
+	ExportPowerLimitationValue13074 uint16                     `register:"export_power_limitation_value,holding,13074" unit:"W"`
+	OffGridOption                   OffGridOption              `register:"off_grid_option,holding,13075"`
+	ExternalEMSHeartbeat            uint16                     `register:"external_ems_heartbeat,holding,13080" unit:"s"`
+	MeterCommDetection              MeterCommDetection         `register:"meter_comm_detection,holding,13086"`
+	ExportPowerLimitation13087      ExportPowerLimitation13087 `register:"export_power_limitation,holding,13087"`
+	ReserveSOCForBackup             uint16                     `register:"reserve_soc_for_backup,holding,13100" unit:"%"`
+}
+
+// Models returns the models with the registers of SH10RT.
+func (SH10RT) Models() []string {
+	return []string{"SH10RT"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SH10RT) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5013, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5014, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT2Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5622, Type: "uint16", Scale: 10, Count: 1}, func(r *sungrow.Register) { d.ExportLimitMin = number(r) }},
+		{false, sungrow.Register{Address: 5623, Type: "uint16", Scale: 10, Count: 1}, func(r *sungrow.Register) { d.ExportLimitMax = number(r) }},
+		{false, sungrow.Register{Address: 5628, Type: "uint16", Scale: 100, Count: 1}, func(r *sungrow.Register) { d.BdcRatedPower = number(r) }},
+		{false, sungrow.Register{Address: 5635, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MaxChargingCurrentBMS = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5636, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MaxDischargingCurrentBMS = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6100, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.PVPowerOfToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6196, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.DailyPVEnergyYields = number(r) }},
+		{false, sungrow.Register{Address: 6227, Type: "uint16", Scale: 1, Count: 12}, func(r *sungrow.Register) { d.MonthlyPVEnergyYields = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6250, Type: "uint32", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.YearlyPVEnergyYields = number(r) }},
+		{false, sungrow.Register{Address: 6290, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.DirectPowerConsumptionTodayPV = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6386, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.DirectPowerConsumptionPV = number(r) }},
+		{false, sungrow.Register{Address: 6417, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.DirectPowerConsumptionMonthlyPV = number(r) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 6469, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.ExportPowerFromPVToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6565, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.ExportPowerFromPV = number(r) }},
+		{false, sungrow.Register{Address: 6596, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.ExportPowerFromPVMonthly = number(r) }},
+		{false, sungrow.Register{Address: 6608, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.ExportPowerFromPVYearly = number(r) }},
+		{false, sungrow.Register{Address: 6648, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6744, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.BatteryChargePowerFromPV = number(r) }},
+		{false, sungrow.Register{Address: 6775, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVMonthly = number(r) }},
+		{false, sungrow.Register{Address: 6787, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVYearly = number(r) }},
+		{false, sungrow.Register{Address: 13000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemState = SystemState(number(r)) }},
+		{false, sungrow.Register{Address: 13001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.RunningState = RunningState(number(r)) }},
+		{false, sungrow.Register{Address: 13002, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPVGeneration = number(r) }},
+		{false, sungrow.Register{Address: 13003, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPVGeneration = number(r) }},
+		{false, sungrow.Register{Address: 13005, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyExportFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13006, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalExportFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13008, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.LoadPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13010, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyBatteryChargeFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13013, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalBatteryChargeFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13015, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.Co2Reduction = number(r) }},
+		{false, sungrow.Register{Address: 13017, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 13018, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 13020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryVoltage = number(r) }},
+		{false, sungrow.Register{Address: 13021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13022, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryLevel = number(r) }},
+		{false, sungrow.Register{Address: 13024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryHealth = number(r) }},
+		{false, sungrow.Register{Address: 13025, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryTemperature = number(r) }},
+		{false, sungrow.Register{Address: 13026, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyBatteryDischargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13027, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalBatteryDischargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13029, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.SelfConsumptionToday = number(r) }},
+		{false, sungrow.Register{Address: 13030, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.GridState = GridState(number(r)) }},
+		{false, sungrow.Register{Address: 13031, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseACurrent = number(r) }},
+		{false, sungrow.Register{Address: 13032, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseBCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13033, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseCCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13034, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13037, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13040, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyChargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13041, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalChargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DrmState = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13045, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13046, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13050, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.InverterAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13052, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.GridSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13054, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemFault1 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13056, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemFault2 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13058, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DCSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13060, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PermanentFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13062, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BdcSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13064, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BdcSidePermanentFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13066, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13068, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13070, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13072, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSProtection = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13074, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSFault1 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13076, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSFault2 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13078, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSAlarm2 = uint32(number(r)) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+		{true, sungrow.Register{Address: 13000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop13000 = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 13002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1AdjustmentMode = Load1AdjustmentMode(number(r)) }},
+		{true, sungrow.Register{Address: 13003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1StartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1StartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1EndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1EndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2StartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13008, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2StartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13009, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2EndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2EndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OnOffMode = Load1OnOffMode(number(r)) }},
+		{true, sungrow.Register{Address: 13012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeStartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeStartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeEndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13015, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeEndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13016, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedPower = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13050, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.EMSModeSelection = EMSModeSelection(number(r)) }},
+		{true, sungrow.Register{Address: 13051, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ChargeDischargeCommand = ChargeDischargeCommand(number(r)) }},
+		{true, sungrow.Register{Address: 13052, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ChargeDischargePower = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13058, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MaxSOC = number(r) }},
+		{true, sungrow.Register{Address: 13059, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MinSOC = number(r) }},
+		{true, sungrow.Register{Address: 13074, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue13074 = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13075, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OffGridOption = OffGridOption(number(r)) }},
+		{true, sungrow.Register{Address: 13080, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExternalEMSHeartbeat = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13086, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterCommDetection = MeterCommDetection(number(r)) }},
+		{true, sungrow.Register{Address: 13087, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation13087 = ExportPowerLimitation13087(number(r)) }},
+		{true, sungrow.Register{Address: 13100, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReserveSOCForBackup = uint16(number(r)) }},
+	})
+}
+
+// SH3d6RS has the registers of the SH3.6RS, SH4.6RS, SH5.0RS and SH6.0RS.
+type SH3d6RS struct {
+	ProtocolNo                      uint32                 `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                 `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                 `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                 `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                 `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode         `register:"device_type_code,input,5000"`
+	NominalActivePower              float64                `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType             `register:"output_type,input,5002"`
+	DailyPowerYields                float64                `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                 `register:"total_power_yields,input,5004" unit:"kWh"`
+	InternalTemperature             float64                `register:"internal_temperature,input,5008" unit:"°C"`
+	MPPT1Voltage                    float64                `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64                `register:"mppt_1_current,input,5012" unit:"A"`
+	TotalDCPower                    uint32                 `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64                `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64                `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64                `register:"voltage_c_phase,input,5021" unit:"V"`
+	TotalReactivePower              int32                  `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64                `register:"power_factor,input,5035"`
+	GridFrequency                   float64                `register:"grid_frequency,input,5036" unit:"Hz"`
+	ExportLimitMin                  float64                `register:"export_limit_min,input,5622" unit:"W"`
+	ExportLimitMax                  float64                `register:"export_limit_max,input,5623" unit:"W"`
+	BdcRatedPower                   float64                `register:"bdc_rated_power,input,5628" unit:"W"`
+	MaxChargingCurrentBMS           uint16                 `register:"max_charging_current_bms,input,5635" unit:"A"`
+	MaxDischargingCurrentBMS        uint16                 `register:"max_discharging_current_bms,input,5636" unit:"A"`
+	PVPowerOfToday                  uint16                 `register:"pv_power_of_today,input,6100" unit:"W"`
+	DailyPVEnergyYields             float64                `register:"daily_pv_energy_yields,input,6196" unit:"kWh"`
+	MonthlyPVEnergyYields           uint16                 `register:"monthly_pv_energy_yields,input,6227" unit:"kWh"`
+	YearlyPVEnergyYields            float64                `register:"yearly_pv_energy_yields,input,6250" unit:"kWh"`
+	DirectPowerConsumptionTodayPV   uint16                 `register:"direct_power_consumption_today_pv,input,6290" unit:"W"`
+	DirectPowerConsumptionPV        float64                `register:"direct_power_consumption_pv,input,6386" unit:"kWh"`
+	DirectPowerConsumptionMonthlyPV float64                `register:"direct_power_consumption_monthly_pv,input,6417" unit:"kWh"`
+	DirectPowerConsumptionYearlyPV  float64                `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	ExportPowerFromPVToday          uint16                 `register:"export_power_from_pv_today,input,6469" unit:"W"`
+	ExportPowerFromPV               float64                `register:"export_power_from_pv,input,6565" unit:"kWh"`
+	ExportPowerFromPVMonthly        float64                `register:"export_power_from_pv_monthly,input,6596" unit:"kWh"`
+	ExportPowerFromPVYearly         float64                `register:"export_power_from_pv_yearly,input,6608" unit:"kWh"`
+	BatteryChargePowerFromPVToday   uint16                 `register:"battery_charge_power_from_pv_today,input,6648" unit:"W"`
+	BatteryChargePowerFromPV        float64                `register:"battery_charge_power_from_pv,input,6744" unit:"kWh"`
+	BatteryChargePowerFromPVMonthly float64                `register:"battery_charge_power_from_pv_monthly,input,6775" unit:"kWh"`
+	BatteryChargePowerFromPVYearly  float64                `register:"battery_charge_power_from_pv_yearly,input,6787" unit:"kWh"`
+	SystemState                     SystemState            `register:"system_state,input,13000"`
+	RunningState                    RunningState           `register:"running_state,input,13001"`
+	DailyPVGeneration               float64                `register:"daily_pv_generation,input,13002" unit:"kWh"`
+	TotalPVGeneration               float64                `register:"total_pv_generation,input,13003" unit:"kWh"`
+	DailyExportFromPV               float64                `register:"daily_export_from_pv,input,13005" unit:"kWh"`
+	TotalExportFromPV               float64                `register:"total_export_from_pv,input,13006" unit:"kWh"`
+	LoadPower                       int32                  `register:"load_power,input,13008" unit:"W"`
+	ExportPower                     int32                  `register:"export_power,input,13010" unit:"W"`
+	DailyBatteryChargeFromPV        float64                `register:"daily_battery_charge_from_pv,input,13012" unit:"kWh"`
+	TotalBatteryChargeFromPV        float64                `register:"total_battery_charge_from_pv,input,13013" unit:"kWh"`
+	Co2Reduction                    float64                `register:"co2_reduction,input,13015" unit:"kg"`
+	DailyDirectEnergyConsumption    float64                `register:"daily_direct_energy_consumption,input,13017" unit:"kWh"`
+	TotalDirectEnergyConsumption    float64                `register:"total_direct_energy_consumption,input,13018" unit:"kWh"`
+	BatteryVoltage                  float64                `register:"battery_voltage,input,13020" unit:"V"`
+	BatteryCurrent                  float64                `register:"battery_current,input,13021" unit:"A"`
+	BatteryPower                    uint16                 `register:"battery_power,input,13022" unit:"W"`
+	BatteryLevel                    float64                `register:"battery_level,input,13023" unit:"%"`
+	BatteryHealth                   float64                `register:"battery_health,input,13024" unit:"%"`
+	BatteryTemperature              float64                `register:"battery_temperature,input,13025" unit:"°C"`
+	DailyBatteryDischargeEnergy     float64                `register:"daily_battery_discharge_energy,input,13026" unit:"kWh"`
+	TotalBatteryDischargeEnergy     float64                `register:"total_battery_discharge_energy,input,13027" unit:"kWh"`
+	SelfConsumptionToday            float64                `register:"self_consumption_today,input,13029" unit:"%"`
+	GridState                       GridState              `register:"grid_state,input,13030"`
+	PhaseACurrent                   float64                `register:"phase_a_current,input,13031" unit:"A"`
+	PhaseBCurrent                   float64                `register:"phase_b_current,input,13032" unit:"A"`
+	PhaseCCurrent                   float64                `register:"phase_c_current,input,13033" unit:"A"`
+	TotalActivePower                int32                  `register:"total_active_power,input,13034" unit:"W"`
+	DailyImportEnergy               float64                `register:"daily_import_energy,input,13036" unit:"kWh"`
+	TotalImportEnergy               float64                `register:"total_import_energy,input,13037" unit:"kWh"`
+	DailyChargeEnergy               float64                `register:"daily_charge_energy,input,13040" unit:"kWh"`
+	TotalChargeEnergy               float64                `register:"total_charge_energy,input,13041" unit:"kWh"`
+	DrmState                        uint16                 `register:"drm_state,input,13043"`
+	DailyExportEnergy               float64                `register:"daily_export_energy,input,13045" unit:"kWh"`
+	TotalExportEnergy               float64                `register:"total_export_energy,input,13046" unit:"kWh"`
+	InverterAlarm                   uint32                 `register:"inverter_alarm,input,13050"`
+	GridSideFault                   uint32                 `register:"grid_side_fault,input,13052"`
+	SystemFault1                    uint32                 `register:"system_fault_1,input,13054"`
+	SystemFault2                    uint32                 `register:"system_fault_2,input,13056"`
+	DCSideFault                     uint32                 `register:"dc_side_fault,input,13058"`
+	PermanentFault                  uint32                 `register:"permanent_fault,input,13060"`
+	BdcSideFault                    uint32                 `register:"bdc_side_fault,input,13062"`
+	BdcSidePermanentFault           uint32                 `register:"bdc_side_permanent_fault,input,13064"`
+	BatteryFault                    uint32                 `register:"battery_fault,input,13066"`
+	BatteryAlarm                    uint32                 `register:"battery_alarm,input,13068"`
+	BMSAlarm                        uint32                 `register:"bms_alarm,input,13070"`
+	BMSProtection                   uint32                 `register:"bms_protection,input,13072"`
+	BMSFault1                       uint32                 `register:"bms_fault_1,input,13074"`
+	BMSFault2                       uint32                 `register:"bms_fault_2,input,13076"`
+	BMSAlarm2                       uint32                 `register:"bms_alarm_2,input,13078"`
+	SystemClockYear                 uint16                 `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                 `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                 `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                 `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                 `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                 `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop              `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch  `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64                `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation  `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                 `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                 `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                 `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer     `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64                `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64                `register:"installed_pv_power,holding,5016" unit:"kW"`
+	StartStop13000                  StartStop              `register:"start_stop,holding,13000"`
+	Load1AdjustmentMode             Load1AdjustmentMode    `register:"load_1_adjustment_mode,holding,13002"`
+	Load1TimingPeriod1StartHour     uint16                 `register:"load_1_timing_period_1_start_hour,holding,13003"`
+	Load1TimingPeriod1StartMinute   uint16                 `register:"load_1_timing_period_1_start_minute,holding,13004"`
+	Load1TimingPeriod1EndHour       uint16                 `register:"load_1_timing_period_1_end_hour,holding,13005"`
+	Load1TimingPeriod1EndMinute     uint16                 `register:"load_1_timing_period_1_end_minute,holding,13006"`
+	Load1TimingPeriod2StartHour     uint16                 `register:"load_1_timing_period_2_start_hour,holding,13007"`
+	Load1TimingPeriod2StartMinute   uint16                 `register:"load_1_timing_period_2_start_minute,holding,13008"`
+	Load1TimingPeriod2EndHour       uint16                 `register:"load_1_timing_period_2_end_hour,holding,13009"`
+	Load1TimingPeriod2EndMinute     uint16                 `register:"load_1_timing_period_2_end_minute,holding,13010"`
+	Load1OnOffMode                  Load1OnOffMode         `register:"load_1_on_off_mode,holding,13011"`
+	Load1OptimizedModeStartHour     uint16                 `register:"load_1_optimized_mode_start_hour,holding,13012"`
+	Load1OptimizedModeStartMinute   uint16                 `register:"load_1_optimized_mode_start_minute,holding,13013"`
+	Load1OptimizedModeEndHour       uint16                 `register:"load_1_optimized_mode_end_hour,holding,13014"`
+	Load1OptimizedModeEndMinute     uint16                 `register:"load_1_optimized_mode_end_minute,holding,13015"`
+	Load1OptimizedPower             uint16                 `register:"load_1_optimized_power,holding,13016" unit:"W"`
+	EMSModeSelection                EMSModeSelection       `register:"ems_mode_selection,holding,13050"`
+	ChargeDischargeCommand          ChargeDischargeCommand `register:"charge_discharge_command,holding,13051"`
+	ChargeDischargePower            uint16                 `register:"charge_discharge_power,holding,13052" unit:"W"`
+	MaxSOC                          float64                `register:"max_soc,holding,13058" unit:"%"`
+	MinSOC                          float64                `register:"min_soc,holding,13059" unit:"%"`
+	ExportPowerLimitationValue13074 uint16                 `register:"export_power_limitation_value,holding,13074" unit:"W"`
+	OffGridOption                   OffGridOption          `register:"off_grid_option,holding,13075"`
+	ExternalEMSHeartbeat            uint16                 `register:"external_ems_heartbeat,holding,13080" unit:"s"`
+	MeterCommDetection              MeterCommDetection     `register:"meter_comm_detection,holding,13086"`
+	ReserveSOCForBackup             uint16                 `register:"reserve_soc_for_backup,holding,13100" unit:"%"`
+}
+
+// Models returns the models with the registers of SH3d6RS.
+func (SH3d6RS) Models() []string {
+	return []string{"SH3.6RS", "SH4.6RS", "SH5.0RS", "SH6.0RS"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SH3d6RS) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5622, Type: "uint16", Scale: 10, Count: 1}, func(r *sungrow.Register) { d.ExportLimitMin = number(r) }},
+		{false, sungrow.Register{Address: 5623, Type: "uint16", Scale: 10, Count: 1}, func(r *sungrow.Register) { d.ExportLimitMax = number(r) }},
+		{false, sungrow.Register{Address: 5628, Type: "uint16", Scale: 100, Count: 1}, func(r *sungrow.Register) { d.BdcRatedPower = number(r) }},
+		{false, sungrow.Register{Address: 5635, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MaxChargingCurrentBMS = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5636, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MaxDischargingCurrentBMS = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6100, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.PVPowerOfToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6196, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.DailyPVEnergyYields = number(r) }},
+		{false, sungrow.Register{Address: 6227, Type: "uint16", Scale: 1, Count: 12}, func(r *sungrow.Register) { d.MonthlyPVEnergyYields = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6250, Type: "uint32", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.YearlyPVEnergyYields = number(r) }},
+		{false, sungrow.Register{Address: 6290, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.DirectPowerConsumptionTodayPV = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6386, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.DirectPowerConsumptionPV = number(r) }},
+		{false, sungrow.Register{Address: 6417, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.DirectPowerConsumptionMonthlyPV = number(r) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 6469, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.ExportPowerFromPVToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6565, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.ExportPowerFromPV = number(r) }},
+		{false, sungrow.Register{Address: 6596, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.ExportPowerFromPVMonthly = number(r) }},
+		{false, sungrow.Register{Address: 6608, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.ExportPowerFromPVYearly = number(r) }},
+		{false, sungrow.Register{Address: 6648, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6744, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.BatteryChargePowerFromPV = number(r) }},
+		{false, sungrow.Register{Address: 6775, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVMonthly = number(r) }},
+		{false, sungrow.Register{Address: 6787, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVYearly = number(r) }},
+		{false, sungrow.Register{Address: 13000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemState = SystemState(number(r)) }},
+		{false, sungrow.Register{Address: 13001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.RunningState = RunningState(number(r)) }},
+		{false, sungrow.Register{Address: 13002, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPVGeneration = number(r) }},
+		{false, sungrow.Register{Address: 13003, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPVGeneration = number(r) }},
+		{false, sungrow.Register{Address: 13005, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyExportFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13006, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalExportFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13008, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.LoadPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13010, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyBatteryChargeFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13013, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalBatteryChargeFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13015, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.Co2Reduction = number(r) }},
+		{false, sungrow.Register{Address: 13017, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 13018, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 13020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryVoltage = number(r) }},
+		{false, sungrow.Register{Address: 13021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13022, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryLevel = number(r) }},
+		{false, sungrow.Register{Address: 13024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryHealth = number(r) }},
+		{false, sungrow.Register{Address: 13025, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryTemperature = number(r) }},
+		{false, sungrow.Register{Address: 13026, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyBatteryDischargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13027, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalBatteryDischargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13029, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.SelfConsumptionToday = number(r) }},
+		{false, sungrow.Register{Address: 13030, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.GridState = GridState(number(r)) }},
+		{false, sungrow.Register{Address: 13031, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseACurrent = number(r) }},
+		{false, sungrow.Register{Address: 13032, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseBCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13033, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseCCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13034, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13037, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13040, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyChargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13041, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalChargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DrmState = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13045, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13046, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13050, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.InverterAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13052, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.GridSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13054, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemFault1 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13056, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemFault2 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13058, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DCSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13060, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PermanentFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13062, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BdcSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13064, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BdcSidePermanentFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13066, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13068, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13070, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13072, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSProtection = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13074, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSFault1 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13076, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSFault2 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13078, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSAlarm2 = uint32(number(r)) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+		{true, sungrow.Register{Address: 13000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop13000 = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 13002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1AdjustmentMode = Load1AdjustmentMode(number(r)) }},
+		{true, sungrow.Register{Address: 13003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1StartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1StartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1EndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1EndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2StartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13008, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2StartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13009, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2EndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2EndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OnOffMode = Load1OnOffMode(number(r)) }},
+		{true, sungrow.Register{Address: 13012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeStartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeStartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeEndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13015, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeEndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13016, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedPower = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13050, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.EMSModeSelection = EMSModeSelection(number(r)) }},
+		{true, sungrow.Register{Address: 13051, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ChargeDischargeCommand = ChargeDischargeCommand(number(r)) }},
+		{true, sungrow.Register{Address: 13052, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ChargeDischargePower = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13058, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MaxSOC = number(r) }},
+		{true, sungrow.Register{Address: 13059, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MinSOC = number(r) }},
+		{true, sungrow.Register{Address: 13074, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue13074 = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13075, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OffGridOption = OffGridOption(number(r)) }},
+		{true, sungrow.Register{Address: 13080, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExternalEMSHeartbeat = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13086, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterCommDetection = MeterCommDetection(number(r)) }},
+		{true, sungrow.Register{Address: 13100, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReserveSOCForBackup = uint16(number(r)) }},
+	})
+}
+
+// SH5KV13 has the registers of the SH5K-V13, SH3K6, SH4K6, SH5K-20, SH3K6-30, SH4K6-30 and SH5K-30.
+type SH5KV13 struct {
+	ProtocolNo                                 uint32                 `register:"protocol_no,input,4950"`
+	ProtocolVer                                uint32                 `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                             uint16                 `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                             uint16                 `register:"dsp_software_ver,input,4969"`
+	SerialNumber                               string                 `register:"serial_number,input,4990"`
+	DeviceTypeCode                             DeviceTypeCode         `register:"device_type_code,input,5000"`
+	NominalActivePower                         float64                `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                                 OutputType             `register:"output_type,input,5002"`
+	DailyPowerYields                           float64                `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                           uint32                 `register:"total_power_yields,input,5004" unit:"kWh"`
+	InternalTemperature                        float64                `register:"internal_temperature,input,5008" unit:"°C"`
+	MPPT1Voltage                               float64                `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                               float64                `register:"mppt_1_current,input,5012" unit:"A"`
+	TotalDCPower                               uint32                 `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                              float64                `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                              float64                `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                              float64                `register:"voltage_c_phase,input,5021" unit:"V"`
+	TotalReactivePower                         int32                  `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                                float64                `register:"power_factor,input,5035"`
+	GridFrequency                              float64                `register:"grid_frequency,input,5036" unit:"Hz"`
+	PVPowerOfToday                             uint16                 `register:"pv_power_of_today,input,6100" unit:"W"`
+	DailyPVEnergyYields                        float64                `register:"daily_pv_energy_yields,input,6196" unit:"kWh"`
+	MonthlyPVEnergyYields                      uint16                 `register:"monthly_pv_energy_yields,input,6227" unit:"kWh"`
+	YearlyPVEnergyYields                       float64                `register:"yearly_pv_energy_yields,input,6250" unit:"kWh"`
+	DirectPowerConsumptionTodayPV              uint16                 `register:"direct_power_consumption_today_pv,input,6290" unit:"W"`
+	DirectPowerConsumptionPV                   float64                `register:"direct_power_consumption_pv,input,6386" unit:"kWh"`
+	DirectPowerConsumptionMonthlyPV            float64                `register:"direct_power_consumption_monthly_pv,input,6417" unit:"kWh"`
+	DirectPowerConsumptionYearlyPV             float64                `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	ExportPowerFromPVToday                     uint16                 `register:"export_power_from_pv_today,input,6469" unit:"W"`
+	ExportPowerFromPV                          float64                `register:"export_power_from_pv,input,6565" unit:"kWh"`
+	ExportPowerFromPVMonthly                   float64                `register:"export_power_from_pv_monthly,input,6596" unit:"kWh"`
+	ExportPowerFromPVYearly                    float64                `register:"export_power_from_pv_yearly,input,6608" unit:"kWh"`
+	BatteryChargePowerFromPVToday              uint16                 `register:"battery_charge_power_from_pv_today,input,6648" unit:"W"`
+	BatteryChargePowerFromPV                   float64                `register:"battery_charge_power_from_pv,input,6744" unit:"kWh"`
+	BatteryChargePowerFromPVMonthly            float64                `register:"battery_charge_power_from_pv_monthly,input,6775" unit:"kWh"`
+	BatteryChargePowerFromPVYearly             float64                `register:"battery_charge_power_from_pv_yearly,input,6787" unit:"kWh"`
+	SystemState                                SystemState            `register:"system_state,input,13000"`
+	RunningState                               RunningState           `register:"running_state,input,13001"`
+	DailyPVGeneration                          float64                `register:"daily_pv_generation,input,13002" unit:"kWh"`
+	TotalPVGeneration                          float64                `register:"total_pv_generation,input,13003" unit:"kWh"`
+	DailyExportFromPV                          float64                `register:"daily_export_from_pv,input,13005" unit:"kWh"`
+	TotalExportFromPV                          float64                `register:"total_export_from_pv,input,13006" unit:"kWh"`
+	LoadPower                                  int32                  `register:"load_power,input,13008" unit:"W"`
+	ExportPower                                int32                  `register:"export_power,input,13010" unit:"W"`
+	DailyBatteryChargeFromPV                   float64                `register:"daily_battery_charge_from_pv,input,13012" unit:"kWh"`
+	TotalBatteryChargeFromPV                   float64                `register:"total_battery_charge_from_pv,input,13013" unit:"kWh"`
+	Co2Reduction                               float64                `register:"co2_reduction,input,13015" unit:"kg"`
+	DailyDirectEnergyConsumption               float64                `register:"daily_direct_energy_consumption,input,13017" unit:"kWh"`
+	TotalDirectEnergyConsumption               float64                `register:"total_direct_energy_consumption,input,13018" unit:"kWh"`
+	BatteryVoltage                             float64                `register:"battery_voltage,input,13020" unit:"V"`
+	BatteryCurrent                             float64                `register:"battery_current,input,13021" unit:"A"`
+	BatteryPower                               uint16                 `register:"battery_power,input,13022" unit:"W"`
+	BatteryLevel                               float64                `register:"battery_level,input,13023" unit:"%"`
+	BatteryHealth                              float64                `register:"battery_health,input,13024" unit:"%"`
+	BatteryTemperature                         float64                `register:"battery_temperature,input,13025" unit:"°C"`
+	DailyBatteryDischargeEnergy                float64                `register:"daily_battery_discharge_energy,input,13026" unit:"kWh"`
+	TotalBatteryDischargeEnergy                float64                `register:"total_battery_discharge_energy,input,13027" unit:"kWh"`
+	SelfConsumptionToday                       float64                `register:"self_consumption_today,input,13029" unit:"%"`
+	GridState                                  GridState              `register:"grid_state,input,13030"`
+	PhaseACurrent                              float64                `register:"phase_a_current,input,13031" unit:"A"`
+	PhaseBCurrent                              float64                `register:"phase_b_current,input,13032" unit:"A"`
+	PhaseCCurrent                              float64                `register:"phase_c_current,input,13033" unit:"A"`
+	TotalActivePower                           int32                  `register:"total_active_power,input,13034" unit:"W"`
+	DailyImportEnergy                          float64                `register:"daily_import_energy,input,13036" unit:"kWh"`
+	BatteryCapacity                            float64                `register:"battery_capacity,input,13039" unit:"kWh/Ah"`
+	TotalImportEnergy                          float64                `register:"total_import_energy,input,13037" unit:"kWh"`
+	BatteryCapacity13039                       float64                `register:"battery_capacity,input,13039" unit:"kWh/Ah"`
+	DailyChargeEnergy                          float64                `register:"daily_charge_energy,input,13040" unit:"kWh"`
+	TotalChargeEnergy                          float64                `register:"total_charge_energy,input,13041" unit:"kWh"`
+	DrmState                                   uint16                 `register:"drm_state,input,13043"`
+	DailyExportEnergy                          float64                `register:"daily_export_energy,input,13045" unit:"kWh"`
+	TotalExportEnergy                          float64                `register:"total_export_energy,input,13046" unit:"kWh"`
+	InverterAlarm                              uint32                 `register:"inverter_alarm,input,13050"`
+	GridSideFault                              uint32                 `register:"grid_side_fault,input,13052"`
+	SystemFault1                               uint32                 `register:"system_fault_1,input,13054"`
+	SystemFault2                               uint32                 `register:"system_fault_2,input,13056"`
+	DCSideFault                                uint32                 `register:"dc_side_fault,input,13058"`
+	PermanentFault                             uint32                 `register:"permanent_fault,input,13060"`
+	BdcSideFault                               uint32                 `register:"bdc_side_fault,input,13062"`
+	BdcSidePermanentFault                      uint32                 `register:"bdc_side_permanent_fault,input,13064"`
+	BatteryFault                               uint32                 `register:"battery_fault,input,13066"`
+	BatteryAlarm                               uint32                 `register:"battery_alarm,input,13068"`
+	BMSAlarm                                   uint32                 `register:"bms_alarm,input,13070"`
+	BMSProtection                              uint32                 `register:"bms_protection,input,13072"`
+	BMSFault1                                  uint32                 `register:"bms_fault_1,input,13074"`
+	BMSFault2                                  uint32                 `register:"bms_fault_2,input,13076"`
+	BMSAlarm2                                  uint32                 `register:"bms_alarm_2,input,13078"`
+	BMSStatus                                  uint16                 `register:"bms_status,input,13100"`
+	MaxChargingCurrent                         uint16                 `register:"max_charging_current,input,13101" unit:"A"`
+	MaxDischargingCurrent                      uint16                 `register:"max_discharging_current,input,13102" unit:"A"`
+	Warning                                    uint16                 `register:"warning,input,13103"`
+	Protection                                 uint16                 `register:"protection,input,13104"`
+	Fault1                                     uint16                 `register:"fault_1,input,13105"`
+	Fault2                                     uint16                 `register:"fault_2,input,13106"`
+	SOC                                        uint16                 `register:"soc,input,13107" unit:"%"`
+	SOH                                        uint16                 `register:"soh,input,13108"`
+	BatteryCurrent13109                        uint16                 `register:"battery_current,input,13109"`
+	BatteryVoltage13110                        float64                `register:"battery_voltage,input,13110" unit:"V"`
+	CycleCount                                 uint16                 `register:"cycle_count,input,13111"`
+	AverageCellVoltage                         uint16                 `register:"average_cell_voltage,input,13112"`
+	MaxCellVoltage                             uint16                 `register:"max_cell_voltage,input,13113"`
+	MinCellVoltage                             uint16                 `register:"min_cell_voltage,input,13114"`
+	BatteryPackVoltage                         uint16                 `register:"battery_pack_voltage,input,13115"`
+	AverageCellTemperature                     uint16                 `register:"average_cell_temperature,input,13116"`
+	MaxCellTemperature                         uint16                 `register:"max_cell_temperature,input,13117"`
+	MinCellTemperature                         uint16                 `register:"min_cell_temperature,input,13118"`
+	SystemClockYear                            uint16                 `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                           uint16                 `register:"system_clock_month,holding,5001"`
+	SystemClockDay                             uint16                 `register:"system_clock_day,holding,5002"`
+	SystemClockHour                            uint16                 `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute                          uint16                 `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond                          uint16                 `register:"system_clock_second,holding,5005"`
+	StartStop                                  StartStop              `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch                      PowerLimitationSwitch  `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting                     float64                `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation                      ExportPowerLimitation  `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue                 uint16                 `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent            uint16                 `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange                    uint16                 `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer                         CurrentTransformer     `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage            float64                `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                           float64                `register:"installed_pv_power,holding,5016" unit:"kW"`
+	StartStop13000                             StartStop              `register:"start_stop,holding,13000"`
+	BatteryMaintenance                         uint16                 `register:"battery_maintenance,holding,13001"`
+	Load1AdjustmentMode                        Load1AdjustmentMode    `register:"load_1_adjustment_mode,holding,13002"`
+	Load1TimingPeriod1StartHour                uint16                 `register:"load_1_timing_period_1_start_hour,holding,13003"`
+	Load1TimingPeriod1StartMinute              uint16                 `register:"load_1_timing_period_1_start_minute,holding,13004"`
+	Load1TimingPeriod1EndHour                  uint16                 `register:"load_1_timing_period_1_end_hour,holding,13005"`
+	Load1TimingPeriod1EndMinute                uint16                 `register:"load_1_timing_period_1_end_minute,holding,13006"`
+	Load1TimingPeriod2StartHour                uint16                 `register:"load_1_timing_period_2_start_hour,holding,13007"`
+	Load1TimingPeriod2StartMinute              uint16                 `register:"load_1_timing_period_2_start_minute,holding,13008"`
+	Load1TimingPeriod2EndHour                  uint16                 `register:"load_1_timing_period_2_end_hour,holding,13009"`
+	Load1TimingPeriod2EndMinute                uint16                 `register:"load_1_timing_period_2_end_minute,holding,13010"`
+	Load1OnOffMode                             Load1OnOffMode         `register:"load_1_on_off_mode,holding,13011"`
+	Load1OptimizedModeStartHour                uint16                 `register:"load_1_optimized_mode_start_hour,holding,13012"`
+	Load1OptimizedModeStartMinute              uint16                 `register:"load_1_optimized_mode_start_minute,holding,13013"`
+	Load1OptimizedModeEndHour                  uint16                 `register:"load_1_optimized_mode_end_hour,holding,13014"`
+	Load1OptimizedModeEndMinute                uint16                 `register:"load_1_optimized_mode_end_minute,holding,13015"`
+	Load1OptimizedPower                        uint16                 `register:"load_1_optimized_power,holding,13016" unit:"W"`
+	EMSModeSelection                           EMSModeSelection       `register:"ems_mode_selection,holding,13050"`
+	ChargeDischargeCommand                     ChargeDischargeCommand `register:"charge_discharge_command,holding,13051"`
+	ChargeDischargePower                       uint16                 `register:"charge_discharge_power,holding,13052" unit:"W"`
+	BatteryType                                BatteryType            `register:"battery_type,holding,13055"`
+	BatteryNominalVoltage                      float64                `register:"battery_nominal_voltage,holding,13056" unit:"V"`
+	BatteryCapacity13057                       uint16                 `register:"battery_capacity,holding,13057" unit:"Ah"`
+	MaxSOC                                     float64                `register:"max_soc,holding,13058" unit:"%"`
+	MinSOC                                     float64                `register:"min_soc,holding,13059" unit:"%"`
+	BatteryOvervoltageThreshold                float64                `register:"battery_overvoltage_threshold,holding,13060" unit:"V"`
+	BatteryUndervoltageThreshold               float64                `register:"battery_undervoltage_threshold,holding,13061" unit:"V"`
+	BatteryOverTemperatureThreshold            float64                `register:"battery_over_temperature_threshold,holding,13062" unit:"°C"`
+	BatteryUnderTemperatureThreshold           float64                `register:"battery_under_temperature_threshold,holding,13062" unit:"°C"`
+	TerminatedCurrentOfConstantVoltageCharging float64                `register:"terminated_current_of_constant_voltage_charging,holding,13065" unit:"C"`
+	MaxDiscargeCurrent                         float64                `register:"max_discarge_current,holding,13066" unit:"C"`
+	MaxChargeCurrent                           float64                `register:"max_charge_current,holding,13067" unit:"C"`
+	ConstantChargeVoltage                      float64                `register:"constant_charge_voltage,holding,13068" unit:"V"`
+	TerminatedVoltageOfDischarging             float64                `register:"terminated_voltage_of_discharging,holding,13069" unit:"V"`
+	EmergencyChargeCurrent                     float64                `register:"emergency_charge_current,holding,13070" unit:"C"`
+	TimeForFullyCharge                         uint16                 `register:"time_for_fully_charge,holding,13071" unit:"s"`
+	TerminatedCurrentForFullCharge             float64                `register:"terminated_current_for_full_charge,holding,13072" unit:"C"`
+	TimeForConstantVoltageCharge               uint16                 `register:"time_for_constant_voltage_charge,holding,13073" unit:"s"`
+	ExportPowerLimitationValue13074            uint16                 `register:"export_power_limitation_value,holding,13074" unit:"W"`
+	OffGridOption                              OffGridOption          `register:"off_grid_option,holding,13075"`
+	ExternalEMSHeartbeat                       uint16                 `register:"external_ems_heartbeat,holding,13080" unit:"s"`
+	ExternalSignalOfItaly                      uint16                 `register:"external_signal_of_italy,holding,13083"`
+	StartChargingPower                         uint16                 `register:"start_charging_power,holding,13084" unit:"W"`
+	StartDischargingPower                      uint16                 `register:"start_discharging_power,holding,13085" unit:"W"`
+	MeterCommDetection                         MeterCommDetection     `register:"meter_comm_detection,holding,13086"`
+	ReserveSOCForBackup                        uint16                 `register:"reserve_soc_for_backup,holding,13100" unit:"%"`
+}
+
+// Models returns the models with the registers of SH5KV13.
+func (SH5KV13) Models() []string {
+	return []string{"SH5K-V13", "SH3K6", "SH4K6", "SH5K-20", "SH3K6-30", "SH4K6-30", "SH5K-30"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SH5KV13) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 6100, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.PVPowerOfToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6196, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.DailyPVEnergyYields = number(r) }},
+		{false, sungrow.Register{Address: 6227, Type: "uint16", Scale: 1, Count: 12}, func(r *sungrow.Register) { d.MonthlyPVEnergyYields = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6250, Type: "uint32", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.YearlyPVEnergyYields = number(r) }},
+		{false, sungrow.Register{Address: 6290, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.DirectPowerConsumptionTodayPV = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6386, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.DirectPowerConsumptionPV = number(r) }},
+		{false, sungrow.Register{Address: 6417, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.DirectPowerConsumptionMonthlyPV = number(r) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 6469, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.ExportPowerFromPVToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6565, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.ExportPowerFromPV = number(r) }},
+		{false, sungrow.Register{Address: 6596, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.ExportPowerFromPVMonthly = number(r) }},
+		{false, sungrow.Register{Address: 6608, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.ExportPowerFromPVYearly = number(r) }},
+		{false, sungrow.Register{Address: 6648, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6744, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.BatteryChargePowerFromPV = number(r) }},
+		{false, sungrow.Register{Address: 6775, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVMonthly = number(r) }},
+		{false, sungrow.Register{Address: 6787, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVYearly = number(r) }},
+		{false, sungrow.Register{Address: 13000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemState = SystemState(number(r)) }},
+		{false, sungrow.Register{Address: 13001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.RunningState = RunningState(number(r)) }},
+		{false, sungrow.Register{Address: 13002, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPVGeneration = number(r) }},
+		{false, sungrow.Register{Address: 13003, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPVGeneration = number(r) }},
+		{false, sungrow.Register{Address: 13005, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyExportFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13006, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalExportFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13008, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.LoadPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13010, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyBatteryChargeFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13013, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalBatteryChargeFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13015, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.Co2Reduction = number(r) }},
+		{false, sungrow.Register{Address: 13017, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 13018, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 13020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryVoltage = number(r) }},
+		{false, sungrow.Register{Address: 13021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13022, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryLevel = number(r) }},
+		{false, sungrow.Register{Address: 13024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryHealth = number(r) }},
+		{false, sungrow.Register{Address: 13025, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryTemperature = number(r) }},
+		{false, sungrow.Register{Address: 13026, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyBatteryDischargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13027, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalBatteryDischargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13029, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.SelfConsumptionToday = number(r) }},
+		{false, sungrow.Register{Address: 13030, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.GridState = GridState(number(r)) }},
+		{false, sungrow.Register{Address: 13031, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseACurrent = number(r) }},
+		{false, sungrow.Register{Address: 13032, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseBCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13033, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseCCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13034, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13039, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryCapacity = number(r) }},
+		{false, sungrow.Register{Address: 13037, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13039, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryCapacity13039 = number(r) }},
+		{false, sungrow.Register{Address: 13040, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyChargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13041, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalChargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DrmState = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13045, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13046, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13050, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.InverterAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13052, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.GridSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13054, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemFault1 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13056, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemFault2 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13058, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DCSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13060, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PermanentFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13062, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BdcSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13064, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BdcSidePermanentFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13066, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13068, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13070, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13072, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSProtection = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13074, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSFault1 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13076, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSFault2 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13078, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSAlarm2 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13100, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSStatus = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13101, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MaxChargingCurrent = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13102, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MaxDischargingCurrent = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13103, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Warning = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13104, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Protection = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13105, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Fault1 = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13106, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Fault2 = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13107, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SOC = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13108, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SOH = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13109, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryCurrent13109 = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13110, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.BatteryVoltage13110 = number(r) }},
+		{false, sungrow.Register{Address: 13111, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CycleCount = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13112, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.AverageCellVoltage = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13113, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MaxCellVoltage = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13114, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MinCellVoltage = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13115, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryPackVoltage = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13116, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.AverageCellTemperature = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13117, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MaxCellTemperature = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13118, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MinCellTemperature = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+		{true, sungrow.Register{Address: 13000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop13000 = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 13001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryMaintenance = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1AdjustmentMode = Load1AdjustmentMode(number(r)) }},
+		{true, sungrow.Register{Address: 13003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1StartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1StartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1EndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1EndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2StartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13008, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2StartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13009, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2EndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2EndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OnOffMode = Load1OnOffMode(number(r)) }},
+		{true, sungrow.Register{Address: 13012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeStartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeStartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeEndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13015, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeEndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13016, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedPower = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13050, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.EMSModeSelection = EMSModeSelection(number(r)) }},
+		{true, sungrow.Register{Address: 13051, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ChargeDischargeCommand = ChargeDischargeCommand(number(r)) }},
+		{true, sungrow.Register{Address: 13052, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ChargeDischargePower = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13055, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryType = BatteryType(number(r)) }},
+		{true, sungrow.Register{Address: 13056, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryNominalVoltage = number(r) }},
+		{true, sungrow.Register{Address: 13057, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryCapacity13057 = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13058, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MaxSOC = number(r) }},
+		{true, sungrow.Register{Address: 13059, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MinSOC = number(r) }},
+		{true, sungrow.Register{Address: 13060, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryOvervoltageThreshold = number(r) }},
+		{true, sungrow.Register{Address: 13061, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryUndervoltageThreshold = number(r) }},
+		{true, sungrow.Register{Address: 13062, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryOverTemperatureThreshold = number(r) }},
+		{true, sungrow.Register{Address: 13062, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryUnderTemperatureThreshold = number(r) }},
+		{true, sungrow.Register{Address: 13065, Type: "uint16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.TerminatedCurrentOfConstantVoltageCharging = number(r) }},
+		{true, sungrow.Register{Address: 13066, Type: "uint16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.MaxDiscargeCurrent = number(r) }},
+		{true, sungrow.Register{Address: 13067, Type: "uint16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.MaxChargeCurrent = number(r) }},
+		{true, sungrow.Register{Address: 13068, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.ConstantChargeVoltage = number(r) }},
+		{true, sungrow.Register{Address: 13069, Type: "uint16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.TerminatedVoltageOfDischarging = number(r) }},
+		{true, sungrow.Register{Address: 13070, Type: "uint16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.EmergencyChargeCurrent = number(r) }},
+		{true, sungrow.Register{Address: 13071, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TimeForFullyCharge = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13072, Type: "uint16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.TerminatedCurrentForFullCharge = number(r) }},
+		{true, sungrow.Register{Address: 13073, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TimeForConstantVoltageCharge = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13074, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue13074 = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13075, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OffGridOption = OffGridOption(number(r)) }},
+		{true, sungrow.Register{Address: 13080, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExternalEMSHeartbeat = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13083, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExternalSignalOfItaly = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13084, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartChargingPower = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13085, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartDischargingPower = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13086, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterCommDetection = MeterCommDetection(number(r)) }},
+		{true, sungrow.Register{Address: 13100, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReserveSOCForBackup = uint16(number(r)) }},
+	})
+}
+
+// SH5d0RT has the registers of the SH5.0RT, SH6.0RT and SH8.0RT.
+type SH5d0RT struct {
+	ProtocolNo                      uint32                     `register:"protocol_no,input,4950"`
+	ProtocolVer                     uint32                     `register:"protocol_ver,input,4952"`
+	ARMSoftwareVer                  uint16                     `register:"arm_software_ver,input,4954"`
+	DSPSoftwareVer                  uint16                     `register:"dsp_software_ver,input,4969"`
+	SerialNumber                    string                     `register:"serial_number,input,4990"`
+	DeviceTypeCode                  DeviceTypeCode             `register:"device_type_code,input,5000"`
+	NominalActivePower              float64                    `register:"nominal_active_power,input,5001" unit:"kW"`
+	OutputType                      OutputType                 `register:"output_type,input,5002"`
+	DailyPowerYields                float64                    `register:"daily_power_yields,input,5003" unit:"kWh"`
+	TotalPowerYields                uint32                     `register:"total_power_yields,input,5004" unit:"kWh"`
+	InternalTemperature             float64                    `register:"internal_temperature,input,5008" unit:"°C"`
+	MPPT1Voltage                    float64                    `register:"mppt_1_voltage,input,5011" unit:"V"`
+	MPPT1Current                    float64                    `register:"mppt_1_current,input,5012" unit:"A"`
+	TotalDCPower                    uint32                     `register:"total_dc_power,input,5017" unit:"W"`
+	VoltageAPhase                   float64                    `register:"voltage_a_phase,input,5019" unit:"V"`
+	VoltageBPhase                   float64                    `register:"voltage_b_phase,input,5020" unit:"V"`
+	VoltageCPhase                   float64                    `register:"voltage_c_phase,input,5021" unit:"V"`
+	TotalReactivePower              int32                      `register:"total_reactive_power,input,5033" unit:"Var"`
+	PowerFactor                     float64                    `register:"power_factor,input,5035"`
+	GridFrequency                   float64                    `register:"grid_frequency,input,5036" unit:"Hz"`
+	ExportLimitMin                  float64                    `register:"export_limit_min,input,5622" unit:"W"`
+	ExportLimitMax                  float64                    `register:"export_limit_max,input,5623" unit:"W"`
+	BdcRatedPower                   float64                    `register:"bdc_rated_power,input,5628" unit:"W"`
+	MaxChargingCurrentBMS           uint16                     `register:"max_charging_current_bms,input,5635" unit:"A"`
+	MaxDischargingCurrentBMS        uint16                     `register:"max_discharging_current_bms,input,5636" unit:"A"`
+	PVPowerOfToday                  uint16                     `register:"pv_power_of_today,input,6100" unit:"W"`
+	DailyPVEnergyYields             float64                    `register:"daily_pv_energy_yields,input,6196" unit:"kWh"`
+	MonthlyPVEnergyYields           uint16                     `register:"monthly_pv_energy_yields,input,6227" unit:"kWh"`
+	YearlyPVEnergyYields            float64                    `register:"yearly_pv_energy_yields,input,6250" unit:"kWh"`
+	DirectPowerConsumptionTodayPV   uint16                     `register:"direct_power_consumption_today_pv,input,6290" unit:"W"`
+	DirectPowerConsumptionPV        float64                    `register:"direct_power_consumption_pv,input,6386" unit:"kWh"`
+	DirectPowerConsumptionMonthlyPV float64                    `register:"direct_power_consumption_monthly_pv,input,6417" unit:"kWh"`
+	DirectPowerConsumptionYearlyPV  float64                    `register:"direct_power_consumption_yearly_pv,input,6429" unit:"kWh"`
+	ExportPowerFromPVToday          uint16                     `register:"export_power_from_pv_today,input,6469" unit:"W"`
+	ExportPowerFromPV               float64                    `register:"export_power_from_pv,input,6565" unit:"kWh"`
+	ExportPowerFromPVMonthly        float64                    `register:"export_power_from_pv_monthly,input,6596" unit:"kWh"`
+	ExportPowerFromPVYearly         float64                    `register:"export_power_from_pv_yearly,input,6608" unit:"kWh"`
+	BatteryChargePowerFromPVToday   uint16                     `register:"battery_charge_power_from_pv_today,input,6648" unit:"W"`
+	BatteryChargePowerFromPV        float64                    `register:"battery_charge_power_from_pv,input,6744" unit:"kWh"`
+	BatteryChargePowerFromPVMonthly float64                    `register:"battery_charge_power_from_pv_monthly,input,6775" unit:"kWh"`
+	BatteryChargePowerFromPVYearly  float64                    `register:"battery_charge_power_from_pv_yearly,input,6787" unit:"kWh"`
+	SystemState                     SystemState                `register:"system_state,input,13000"`
+	RunningState                    RunningState               `register:"running_state,input,13001"`
+	DailyPVGeneration               float64                    `register:"daily_pv_generation,input,13002" unit:"kWh"`
+	TotalPVGeneration               float64                    `register:"total_pv_generation,input,13003" unit:"kWh"`
+	DailyExportFromPV               float64                    `register:"daily_export_from_pv,input,13005" unit:"kWh"`
+	TotalExportFromPV               float64                    `register:"total_export_from_pv,input,13006" unit:"kWh"`
+	LoadPower                       int32                      `register:"load_power,input,13008" unit:"W"`
+	ExportPower                     int32                      `register:"export_power,input,13010" unit:"W"`
+	DailyBatteryChargeFromPV        float64                    `register:"daily_battery_charge_from_pv,input,13012" unit:"kWh"`
+	TotalBatteryChargeFromPV        float64                    `register:"total_battery_charge_from_pv,input,13013" unit:"kWh"`
+	Co2Reduction                    float64                    `register:"co2_reduction,input,13015" unit:"kg"`
+	DailyDirectEnergyConsumption    float64                    `register:"daily_direct_energy_consumption,input,13017" unit:"kWh"`
+	TotalDirectEnergyConsumption    float64                    `register:"total_direct_energy_consumption,input,13018" unit:"kWh"`
+	BatteryVoltage                  float64                    `register:"battery_voltage,input,13020" unit:"V"`
+	BatteryCurrent                  float64                    `register:"battery_current,input,13021" unit:"A"`
+	BatteryPower                    uint16                     `register:"battery_power,input,13022" unit:"W"`
+	BatteryLevel                    float64                    `register:"battery_level,input,13023" unit:"%"`
+	BatteryHealth                   float64                    `register:"battery_health,input,13024" unit:"%"`
+	BatteryTemperature              float64                    `register:"battery_temperature,input,13025" unit:"°C"`
+	DailyBatteryDischargeEnergy     float64                    `register:"daily_battery_discharge_energy,input,13026" unit:"kWh"`
+	TotalBatteryDischargeEnergy     float64                    `register:"total_battery_discharge_energy,input,13027" unit:"kWh"`
+	SelfConsumptionToday            float64                    `register:"self_consumption_today,input,13029" unit:"%"`
+	GridState                       GridState                  `register:"grid_state,input,13030"`
+	PhaseACurrent                   float64                    `register:"phase_a_current,input,13031" unit:"A"`
+	PhaseBCurrent                   float64                    `register:"phase_b_current,input,13032" unit:"A"`
+	PhaseCCurrent                   float64                    `register:"phase_c_current,input,13033" unit:"A"`
+	TotalActivePower                int32                      `register:"total_active_power,input,13034" unit:"W"`
+	DailyImportEnergy               float64                    `register:"daily_import_energy,input,13036" unit:"kWh"`
+	TotalImportEnergy               float64                    `register:"total_import_energy,input,13037" unit:"kWh"`
+	DailyChargeEnergy               float64                    `register:"daily_charge_energy,input,13040" unit:"kWh"`
+	TotalChargeEnergy               float64                    `register:"total_charge_energy,input,13041" unit:"kWh"`
+	DrmState                        uint16                     `register:"drm_state,input,13043"`
+	DailyExportEnergy               float64                    `register:"daily_export_energy,input,13045" unit:"kWh"`
+	TotalExportEnergy               float64                    `register:"total_export_energy,input,13046" unit:"kWh"`
+	InverterAlarm                   uint32                     `register:"inverter_alarm,input,13050"`
+	GridSideFault                   uint32                     `register:"grid_side_fault,input,13052"`
+	SystemFault1                    uint32                     `register:"system_fault_1,input,13054"`
+	SystemFault2                    uint32                     `register:"system_fault_2,input,13056"`
+	DCSideFault                     uint32                     `register:"dc_side_fault,input,13058"`
+	PermanentFault                  uint32                     `register:"permanent_fault,input,13060"`
+	BdcSideFault                    uint32                     `register:"bdc_side_fault,input,13062"`
+	BdcSidePermanentFault           uint32                     `register:"bdc_side_permanent_fault,input,13064"`
+	BatteryFault                    uint32                     `register:"battery_fault,input,13066"`
+	BatteryAlarm                    uint32                     `register:"battery_alarm,input,13068"`
+	BMSAlarm                        uint32                     `register:"bms_alarm,input,13070"`
+	BMSProtection                   uint32                     `register:"bms_protection,input,13072"`
+	BMSFault1                       uint32                     `register:"bms_fault_1,input,13074"`
+	BMSFault2                       uint32                     `register:"bms_fault_2,input,13076"`
+	BMSAlarm2                       uint32                     `register:"bms_alarm_2,input,13078"`
+	SystemClockYear                 uint16                     `register:"system_clock_year,holding,5000"`
+	SystemClockMonth                uint16                     `register:"system_clock_month,holding,5001"`
+	SystemClockDay                  uint16                     `register:"system_clock_day,holding,5002"`
+	SystemClockHour                 uint16                     `register:"system_clock_hour,holding,5003"`
+	SystemClockMinute               uint16                     `register:"system_clock_minute,holding,5004"`
+	SystemClockSecond               uint16                     `register:"system_clock_second,holding,5005"`
+	StartStop                       StartStop                  `register:"start_stop,holding,5006"`
+	PowerLimitationSwitch           PowerLimitationSwitch      `register:"power_limitation_switch,holding,5007"`
+	PowerLimitationSetting          float64                    `register:"power_limitation_setting,holding,5008" unit:"%"`
+	ExportPowerLimitation           ExportPowerLimitation      `register:"export_power_limitation,holding,5010"`
+	ExportPowerLimitationValue      uint16                     `register:"export_power_limitation_value,holding,5011"`
+	CurrentTransformerOutputCurrent uint16                     `register:"current_transformer_output_current,holding,5012" unit:"A"`
+	CurrentTransformerRange         uint16                     `register:"current_transformer_range,holding,5013" unit:"A"`
+	CurrentTransformer              CurrentTransformer         `register:"current_transformer,holding,5014"`
+	ExportPowerLimitationPercentage float64                    `register:"export_power_limitation_percentage,holding,5015" unit:"%"`
+	InstalledPVPower                float64                    `register:"installed_pv_power,holding,5016" unit:"kW"`
+	StartStop13000                  StartStop                  `register:"start_stop,holding,13000"`
+	Load1AdjustmentMode             Load1AdjustmentMode        `register:"load_1_adjustment_mode,holding,13002"`
+	Load1TimingPeriod1StartHour     uint16                     `register:"load_1_timing_period_1_start_hour,holding,13003"`
+	Load1TimingPeriod1StartMinute   uint16                     `register:"load_1_timing_period_1_start_minute,holding,13004"`
+	Load1TimingPeriod1EndHour       uint16                     `register:"load_1_timing_period_1_end_hour,holding,13005"`
+	Load1TimingPeriod1EndMinute     uint16                     `register:"load_1_timing_period_1_end_minute,holding,13006"`
+	Load1TimingPeriod2StartHour     uint16                     `register:"load_1_timing_period_2_start_hour,holding,13007"`
+	Load1TimingPeriod2StartMinute   uint16                     `register:"load_1_timing_period_2_start_minute,holding,13008"`
+	Load1TimingPeriod2EndHour       uint16                     `register:"load_1_timing_period_2_end_hour,holding,13009"`
+	Load1TimingPeriod2EndMinute     uint16                     `register:"load_1_timing_period_2_end_minute,holding,13010"`
+	Load1OnOffMode                  Load1OnOffMode             `register:"load_1_on_off_mode,holding,13011"`
+	Load1OptimizedModeStartHour     uint16                     `register:"load_1_optimized_mode_start_hour,holding,13012"`
+	Load1OptimizedModeStartMinute   uint16                     `register:"load_1_optimized_mode_start_minute,holding,13013"`
+	Load1OptimizedModeEndHour       uint16                     `register:"load_1_optimized_mode_end_hour,holding,13014"`
+	Load1OptimizedModeEndMinute     uint16                     `register:"load_1_optimized_mode_end_minute,holding,13015"`
+	Load1OptimizedPower             uint16                     `register:"load_1_optimized_power,holding,13016" unit:"W"`
+	EMSModeSelection                EMSModeSelection           `register:"ems_mode_selection,holding,13050"`
+	ChargeDischargeCommand          ChargeDischargeCommand     `register:"charge_discharge_command,holding,13051"`
+	ChargeDischargePower            uint16                     `register:"charge_discharge_power,holding,13052" unit:"W"`
+	MaxSOC                          float64                    `register:"max_soc,holding,13058" unit:"%"`
+	MinSOC                          float64                    `register:"min_soc,holding,13059" unit:"%"`
+	ExportPowerLimitationValue13074 uint16                     `register:"export_power_limitation_value,holding,13074" unit:"W"`
+	OffGridOption                   OffGridOption              `register:"off_grid_option,holding,13075"`
+	ExternalEMSHeartbeat            uint16                     `register:"external_ems_heartbeat,holding,13080" unit:"s"`
+	MeterCommDetection              MeterCommDetection         `register:"meter_comm_detection,holding,13086"`
+	ExportPowerLimitation13087      ExportPowerLimitation13087 `register:"export_power_limitation,holding,13087"`
+	ReserveSOCForBackup             uint16                     `register:"reserve_soc_for_backup,holding,13100" unit:"%"`
+}
+
+// Models returns the models with the registers of SH5d0RT.
+func (SH5d0RT) Models() []string {
+	return []string{"SH5.0RT", "SH6.0RT", "SH8.0RT"}
+}
+
+// Read reads the registers from the device, those it refuses are left as they were.
+func (d *SH5d0RT) Read(client sungrow.Modbus) error {
+	return read(client, []field{
+		{false, sungrow.Register{Address: 4950, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolNo = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4952, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ProtocolVer = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 4954, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.ARMSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4969, Type: "uint16", Scale: 1, Count: 15}, func(r *sungrow.Register) { d.DSPSoftwareVer = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 4990, Type: "string", Scale: 1, Count: 10}, func(r *sungrow.Register) { d.SerialNumber = text(r) }},
+		{false, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DeviceTypeCode = DeviceTypeCode(number(r)) }},
+		{false, sungrow.Register{Address: 5001, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.NominalActivePower = number(r) }},
+		{false, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OutputType = OutputType(number(r)) }},
+		{false, sungrow.Register{Address: 5003, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPowerYields = number(r) }},
+		{false, sungrow.Register{Address: 5004, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalPowerYields = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5008, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.InternalTemperature = number(r) }},
+		{false, sungrow.Register{Address: 5011, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Voltage = number(r) }},
+		{false, sungrow.Register{Address: 5012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MPPT1Current = number(r) }},
+		{false, sungrow.Register{Address: 5017, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalDCPower = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 5019, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageAPhase = number(r) }},
+		{false, sungrow.Register{Address: 5020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageBPhase = number(r) }},
+		{false, sungrow.Register{Address: 5021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.VoltageCPhase = number(r) }},
+		{false, sungrow.Register{Address: 5033, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalReactivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 5035, Type: "int16", Scale: 0.001, Count: 1}, func(r *sungrow.Register) { d.PowerFactor = number(r) }},
+		{false, sungrow.Register{Address: 5036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.GridFrequency = number(r) }},
+		{false, sungrow.Register{Address: 5622, Type: "uint16", Scale: 10, Count: 1}, func(r *sungrow.Register) { d.ExportLimitMin = number(r) }},
+		{false, sungrow.Register{Address: 5623, Type: "uint16", Scale: 10, Count: 1}, func(r *sungrow.Register) { d.ExportLimitMax = number(r) }},
+		{false, sungrow.Register{Address: 5628, Type: "uint16", Scale: 100, Count: 1}, func(r *sungrow.Register) { d.BdcRatedPower = number(r) }},
+		{false, sungrow.Register{Address: 5635, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MaxChargingCurrentBMS = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 5636, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MaxDischargingCurrentBMS = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6100, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.PVPowerOfToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6196, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.DailyPVEnergyYields = number(r) }},
+		{false, sungrow.Register{Address: 6227, Type: "uint16", Scale: 1, Count: 12}, func(r *sungrow.Register) { d.MonthlyPVEnergyYields = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6250, Type: "uint32", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.YearlyPVEnergyYields = number(r) }},
+		{false, sungrow.Register{Address: 6290, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.DirectPowerConsumptionTodayPV = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6386, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.DirectPowerConsumptionPV = number(r) }},
+		{false, sungrow.Register{Address: 6417, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.DirectPowerConsumptionMonthlyPV = number(r) }},
+		{false, sungrow.Register{Address: 6429, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.DirectPowerConsumptionYearlyPV = number(r) }},
+		{false, sungrow.Register{Address: 6469, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.ExportPowerFromPVToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6565, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.ExportPowerFromPV = number(r) }},
+		{false, sungrow.Register{Address: 6596, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.ExportPowerFromPVMonthly = number(r) }},
+		{false, sungrow.Register{Address: 6608, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.ExportPowerFromPVYearly = number(r) }},
+		{false, sungrow.Register{Address: 6648, Type: "uint16", Scale: 1, Count: 96}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVToday = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 6744, Type: "uint16", Scale: 0.1, Count: 31}, func(r *sungrow.Register) { d.BatteryChargePowerFromPV = number(r) }},
+		{false, sungrow.Register{Address: 6775, Type: "uint16", Scale: 0.1, Count: 12}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVMonthly = number(r) }},
+		{false, sungrow.Register{Address: 6787, Type: "uint16", Scale: 0.1, Count: 20}, func(r *sungrow.Register) { d.BatteryChargePowerFromPVYearly = number(r) }},
+		{false, sungrow.Register{Address: 13000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemState = SystemState(number(r)) }},
+		{false, sungrow.Register{Address: 13001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.RunningState = RunningState(number(r)) }},
+		{false, sungrow.Register{Address: 13002, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyPVGeneration = number(r) }},
+		{false, sungrow.Register{Address: 13003, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalPVGeneration = number(r) }},
+		{false, sungrow.Register{Address: 13005, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyExportFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13006, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalExportFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13008, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.LoadPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13010, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13012, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyBatteryChargeFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13013, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalBatteryChargeFromPV = number(r) }},
+		{false, sungrow.Register{Address: 13015, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.Co2Reduction = number(r) }},
+		{false, sungrow.Register{Address: 13017, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 13018, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalDirectEnergyConsumption = number(r) }},
+		{false, sungrow.Register{Address: 13020, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryVoltage = number(r) }},
+		{false, sungrow.Register{Address: 13021, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13022, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryPower = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13023, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryLevel = number(r) }},
+		{false, sungrow.Register{Address: 13024, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryHealth = number(r) }},
+		{false, sungrow.Register{Address: 13025, Type: "int16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.BatteryTemperature = number(r) }},
+		{false, sungrow.Register{Address: 13026, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyBatteryDischargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13027, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalBatteryDischargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13029, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.SelfConsumptionToday = number(r) }},
+		{false, sungrow.Register{Address: 13030, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.GridState = GridState(number(r)) }},
+		{false, sungrow.Register{Address: 13031, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseACurrent = number(r) }},
+		{false, sungrow.Register{Address: 13032, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseBCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13033, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PhaseCCurrent = number(r) }},
+		{false, sungrow.Register{Address: 13034, Type: "int32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.TotalActivePower = int32(number(r)) }},
+		{false, sungrow.Register{Address: 13036, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13037, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalImportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13040, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyChargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13041, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalChargeEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13043, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DrmState = uint16(number(r)) }},
+		{false, sungrow.Register{Address: 13045, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.DailyExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13046, Type: "uint32", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.TotalExportEnergy = number(r) }},
+		{false, sungrow.Register{Address: 13050, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.InverterAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13052, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.GridSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13054, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemFault1 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13056, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemFault2 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13058, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.DCSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13060, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PermanentFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13062, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BdcSideFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13064, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BdcSidePermanentFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13066, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryFault = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13068, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BatteryAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13070, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSAlarm = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13072, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSProtection = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13074, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSFault1 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13076, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSFault2 = uint32(number(r)) }},
+		{false, sungrow.Register{Address: 13078, Type: "uint32", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.BMSAlarm2 = uint32(number(r)) }},
+		{true, sungrow.Register{Address: 5000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockYear = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5001, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMonth = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockDay = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.SystemClockSecond = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 5007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSwitch = PowerLimitationSwitch(number(r)) }},
+		{true, sungrow.Register{Address: 5008, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.PowerLimitationSetting = number(r) }},
+		{true, sungrow.Register{Address: 5010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation = ExportPowerLimitation(number(r)) }},
+		{true, sungrow.Register{Address: 5011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerOutputCurrent = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformerRange = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 5014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.CurrentTransformer = CurrentTransformer(number(r)) }},
+		{true, sungrow.Register{Address: 5015, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationPercentage = number(r) }},
+		{true, sungrow.Register{Address: 5016, Type: "uint16", Scale: 0.01, Count: 1}, func(r *sungrow.Register) { d.InstalledPVPower = number(r) }},
+		{true, sungrow.Register{Address: 13000, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.StartStop13000 = StartStop(number(r)) }},
+		{true, sungrow.Register{Address: 13002, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1AdjustmentMode = Load1AdjustmentMode(number(r)) }},
+		{true, sungrow.Register{Address: 13003, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1StartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13004, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1StartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13005, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1EndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13006, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod1EndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13007, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2StartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13008, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2StartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13009, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2EndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13010, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1TimingPeriod2EndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13011, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OnOffMode = Load1OnOffMode(number(r)) }},
+		{true, sungrow.Register{Address: 13012, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeStartHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13013, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeStartMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13014, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeEndHour = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13015, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedModeEndMinute = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13016, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.Load1OptimizedPower = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13050, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.EMSModeSelection = EMSModeSelection(number(r)) }},
+		{true, sungrow.Register{Address: 13051, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ChargeDischargeCommand = ChargeDischargeCommand(number(r)) }},
+		{true, sungrow.Register{Address: 13052, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ChargeDischargePower = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13058, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MaxSOC = number(r) }},
+		{true, sungrow.Register{Address: 13059, Type: "uint16", Scale: 0.1, Count: 1}, func(r *sungrow.Register) { d.MinSOC = number(r) }},
+		{true, sungrow.Register{Address: 13074, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitationValue13074 = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13075, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.OffGridOption = OffGridOption(number(r)) }},
+		{true, sungrow.Register{Address: 13080, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExternalEMSHeartbeat = uint16(number(r)) }},
+		{true, sungrow.Register{Address: 13086, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.MeterCommDetection = MeterCommDetection(number(r)) }},
+		{true, sungrow.Register{Address: 13087, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ExportPowerLimitation13087 = ExportPowerLimitation13087(number(r)) }},
+		{true, sungrow.Register{Address: 13100, Type: "uint16", Scale: 1, Count: 1}, func(r *sungrow.Register) { d.ReserveSOCForBackup = uint16(number(r)) }},
+	})
+}
+
+var constructors = map[string]func() Device{
+	"LP_P34KSG":     func() Device { return new(LPP34KSG) },
+	"SG60KU":        func() Device { return new(LPP34KSG) },
+	"SG10KTL":       func() Device { return new(SG10KTL) },
+	"SG30KTL":       func() Device { return new(SG10KTL) },
+	"SG15KTL":       func() Device { return new(SG10KTL) },
+	"SG12KTL":       func() Device { return new(SG10KTL) },
+	"SG20KTL":       func() Device { return new(SG10KTL) },
+	"SG30KU":        func() Device { return new(SG10KTL) },
+	"SG36KTL":       func() Device { return new(SG10KTL) },
+	"SG36KU":        func() Device { return new(SG10KTL) },
+	"SG40KTL":       func() Device { return new(SG10KTL) },
+	"SG34KJ":        func() Device { return new(SG10KTL) },
+	"SG110CX":       func() Device { return new(SG110CX) },
+	"SG75CX":        func() Device { return new(SG110CX) },
+	"SG125HV":       func() Device { return new(SG125HV) },
+	"SG111HV":       func() Device { return new(SG125HV) },
+	"SG125HV-20":    func() Device { return new(SG125HV) },
+	"SG12KTL-M":     func() Device { return new(SG12KTLM) },
+	"SG10KTL-M":     func() Device { return new(SG12KTLM) },
+	"SG8KTL-M":      func() Device { return new(SG12KTLM) },
+	"SG15KTL-M":     func() Device { return new(SG12KTLM) },
+	"SG20KTL-M":     func() Device { return new(SG12KTLM) },
+	"SG6KTL-MT":     func() Device { return new(SG12KTLM) },
+	"SG17KTL-M":     func() Device { return new(SG12KTLM) },
+	"SG10KTL-MT":    func() Device { return new(SG12KTLM) },
+	"SG250HX":       func() Device { return new(SG250HX) },
+	"SG250HX-US":    func() Device { return new(SG250HX) },
+	"SG100CX":       func() Device { return new(SG250HX) },
+	"SG250HX-IN":    func() Device { return new(SG250HX) },
+	"SG30KTL-M-V31": func() Device { return new(SG30KTLMV31) },
+	"SG33KTL-M":     func() Device { return new(SG30KTLMV31) },
+	"SG30KTL-M":     func() Device { return new(SG30KTLMV31) },
+	"SG33CX":        func() Device { return new(SG33CX) },
+	"SG36CX-US":     func() Device { return new(SG33CX) },
+	"SG30CX":        func() Device { return new(SG33CX) },
+	"SG25CX-SA":     func() Device { return new(SG33CX) },
+	"SG36KTL-M":     func() Device { return new(SG36KTLM) },
+	"SG40KTL-M":     func() Device { return new(SG36KTLM) },
+	"SG33K3J":       func() Device { return new(SG36KTLM) },
+	"SG49K5J":       func() Device { return new(SG49K5J) },
+	"SG50CX":        func() Device { return new(SG50CX) },
+	"SG60CX-US":     func() Device { return new(SG50CX) },
+	"SG50KTL-M":     func() Device { return new(SG50KTLM) },
+	"SG60KTL-M":     func() Device { return new(SG50KTLM) },
+	"SG60KU-M":      func() Device { return new(SG50KTLM) },
+	"SG5KTL-MT":     func() Device { return new(SG5KTLMT) },
+	"SG5.0RT":       func() Device { return new(SG5d0RT) },
+	"SG6.0RT":       func() Device { return new(SG5d0RT) },
+	"SG8.0RT":       func() Device { return new(SG5d0RT) },
+	"SG10RT":        func() Device { return new(SG5d0RT) },
+	"SG12RT":        func() Device { return new(SG5d0RT) },
+	"SG15RT":        func() Device { return new(SG5d0RT) },
+	"SG17RT":        func() Device { return new(SG5d0RT) },
+	"SG20RT":        func() Device { return new(SG5d0RT) },
+	"SG7.0RT":       func() Device { return new(SG5d0RT) },
+	"SG3.0RT":       func() Device { return new(SG5d0RT) },
+	"SG4.0RT":       func() Device { return new(SG5d0RT) },
+	"SG60KTL":       func() Device { return new(SG60KTL) },
+	"SG80KTL":       func() Device { return new(SG60KTL) },
+	"SG80KTL-M":     func() Device { return new(SG80KTLM) },
+	"SG40CX":        func() Device { return new(SG80KTLM) },
+	"SH10RT":        func() Device { return new(SH10RT) },
+	"SH3.6RS":       func() Device { return new(SH3d6RS) },
+	"SH4.6RS":       func() Device { return new(SH3d6RS) },
+	"SH5.0RS":       func() Device { return new(SH3d6RS) },
+	"SH6.0RS":       func() Device { return new(SH3d6RS) },
+	"SH5K-V13":      func() Device { return new(SH5KV13) },
+	"SH3K6":         func() Device { return new(SH5KV13) },
+	"SH4K6":         func() Device { return new(SH5KV13) },
+	"SH5K-20":       func() Device { return new(SH5KV13) },
+	"SH3K6-30":      func() Device { return new(SH5KV13) },
+	"SH4K6-30":      func() Device { return new(SH5KV13) },
+	"SH5K-30":       func() Device { return new(SH5KV13) },
+	"SH5.0RT":       func() Device { return new(SH5d0RT) },
+	"SH6.0RT":       func() Device { return new(SH5d0RT) },
+	"SH8.0RT":       func() Device { return new(SH5d0RT) },
+}
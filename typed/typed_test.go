@@ -0,0 +1,92 @@
+package typed_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/transport"
+	"github.com/freman/sungrow/typed"
+	"github.com/freman/sungrow/typegen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerated(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.DefineDefault())
+
+	src, err := typegen.Generate(&inv, "typed")
+	requires.NoError(err)
+
+	committed, err := os.ReadFile("typed_gen.go")
+	requires.NoError(err)
+
+	requires.Equal(string(src), string(committed), "typed_gen.go is out of date, run go generate ./typed")
+}
+
+func TestRead(t *testing.T) {
+	requires := require.New(t)
+
+	recording := filepath.Join("..", "testdata", "recordings", "fake-sg5ktl-mt.jsonl")
+
+	client, err := transport.ReplayFile(recording)
+	requires.NoError(err)
+	defer client.Close()
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.DefineDefault())
+	requires.NoError(inv.Read(client))
+
+	d := typed.New(inv.Model())
+	requires.IsType(&typed.SG5KTLMT{}, d)
+	requires.Contains(d.Models(), "SG5KTL-MT")
+
+	client, err = transport.ReplayFile(recording)
+	requires.NoError(err)
+	defer client.Close()
+
+	requires.NoError(d.Read(client))
+	sg := d.(*typed.SG5KTLMT)
+
+	requires.Equal(typed.DeviceTypeCodeSG5KTLMT, sg.DeviceTypeCode)
+	requires.Equal("SG5KTL-MT", sg.DeviceTypeCode.String())
+
+	find := func(name string) *sungrow.Register {
+		r := inv.Registers.Find(name, inv.Model())
+		requires.NotNil(r, name)
+		return r
+	}
+
+	requires.Equal(find("serial_number").Value, sg.SerialNumber)
+	requires.Equal(find("daily_power_yields").Value, sg.DailyPowerYields)
+	requires.Equal(find("work_state").Value, sg.WorkState.String())
+
+	total, _ := find("total_active_power").Float()
+	requires.Equal(total, float64(sg.TotalActivePower))
+
+	field, isa := reflect.TypeOf(sg).Elem().FieldByName("DailyPowerYields")
+	requires.True(isa)
+	requires.Equal("kWh", field.Tag.Get("unit"))
+	requires.Equal("daily_power_yields,input,5003", field.Tag.Get("register"))
+
+	requires.Nil(typed.New("not a model"))
+
+	// Decimal points in model numbers become a d
+	requires.IsType(&typed.SH5d0RT{}, typed.New("SH5.0RT"))
+}
+
+func TestFlags(t *testing.T) {
+	requires := require.New(t)
+
+	state := typed.RunningStateCharging | typed.RunningStateLoadIsActive
+	requires.True(state.Has(typed.RunningStateCharging))
+	requires.False(state.Has(typed.RunningStateDischarging))
+	requires.Equal([]string{"Charging", "Load is active"}, state.Flags())
+	requires.Equal("Charging, Load is active", state.String())
+
+	requires.Equal("WorkState(12345)", typed.WorkState(12345).String())
+}
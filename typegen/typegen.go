@@ -0,0 +1,387 @@
+// Package typegen generates Go types from the register definition so they
+// can be read without looking registers up by name. Models with exactly the
+// same registers share a type, named after the first of them in
+// device_type_code order, which needn't follow Sungrow's families.
+package typegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/freman/sungrow"
+)
+
+// family is models that have exactly the same registers, not necessarily
+// one of Sungrow's families.
+type family struct {
+	// Go type name, after the first of the models
+	Name   string
+	Models []string
+	Fields []field
+}
+
+// field is a register of a family.
+type field struct {
+	Name     string
+	Holding  bool
+	Register sungrow.Register
+	// Go type of the field
+	Type string
+}
+
+// named is an enum or bitset type
+type named struct {
+	name   string
+	base   string
+	labels map[int]string
+	bits   bool
+}
+
+type generator struct {
+	inv      *sungrow.Inverter
+	families []*family
+	types    []*named
+	// Type by register name and labels
+	byLabels map[string]*named
+}
+
+// Generate returns the formatted source of package pkg with the types of
+// the definition.
+func Generate(inv *sungrow.Inverter, pkg string) ([]byte, error) {
+	g := &generator{inv: inv, byLabels: map[string]*named{}}
+
+	models, err := g.models()
+	if err != nil {
+		return nil, err
+	}
+
+	g.group(models)
+
+	var buf bytes.Buffer
+	g.write(&buf, pkg)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated code doesn't compile: %w", err)
+	}
+
+	return src, nil
+}
+
+// models lists the models named by device_type_code, in code order.
+func (g *generator) models() ([]string, error) {
+	for _, r := range g.inv.Registers.Input {
+		if r.Name != "device_type_code" {
+			continue
+		}
+
+		codes := make([]int, 0, len(r.Values))
+		for code := range r.Values {
+			codes = append(codes, code)
+		}
+		sort.Ints(codes)
+
+		var models []string
+		for _, code := range codes {
+			models = append(models, label(r.Values[code]))
+		}
+		return models, nil
+	}
+
+	return nil, fmt.Errorf("definition has no device_type_code values to take the models from")
+}
+
+func (g *generator) group(models []string) {
+	byKey := map[string]*family{}
+
+	for _, model := range models {
+		var key strings.Builder
+		var fields []field
+		taken := map[string]bool{}
+
+		for _, holding := range []bool{false, true} {
+			regs := g.inv.Registers.Input
+			if holding {
+				regs = g.inv.Registers.Holding
+			}
+
+			for i, r := range regs {
+				if !r.Models.ContainsOrNull(model) {
+					continue
+				}
+				fmt.Fprintf(&key, "%t/%d,", holding, i)
+
+				// Later definitions of a name get the address to tell them apart
+				name := goName(r.Name)
+				if taken[name] {
+					name = fmt.Sprintf("%s%d", name, r.Address)
+				}
+				taken[name] = true
+
+				fields = append(fields, field{Name: name, Holding: holding, Register: r, Type: g.fieldType(r)})
+			}
+		}
+
+		if f, isa := byKey[key.String()]; isa {
+			f.Models = append(f.Models, model)
+			continue
+		}
+
+		f := &family{Name: goName(model), Models: []string{model}, Fields: fields}
+		byKey[key.String()] = f
+		g.families = append(g.families, f)
+	}
+
+	sort.Slice(g.families, func(i, j int) bool {
+		return g.families[i].Name < g.families[j].Name
+	})
+}
+
+func (g *generator) fieldType(r sungrow.Register) string {
+	base := "uint16"
+	switch r.Type {
+	case "string":
+		return "string"
+	case "int16", "int32", "uint32":
+		base = r.Type
+	}
+
+	switch {
+	case len(r.Values) > 0:
+		labels := map[int]string{}
+		for v, l := range r.Values {
+			labels[v] = label(l)
+		}
+		return g.named(r, base, labels, false)
+	case len(r.Bits) > 0:
+		return g.named(r, base, r.Bits, true)
+	case r.Scale != 1:
+		return "float64"
+	}
+
+	return base
+}
+
+// named finds or declares the type of a register with labels, registers
+// with the same name and labels share a type
+func (g *generator) named(r sungrow.Register, base string, labels map[int]string, bits bool) string {
+	keys := make([]int, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	var key strings.Builder
+	fmt.Fprintf(&key, "%s/%s/%t", r.Name, base, bits)
+	for _, k := range keys {
+		fmt.Fprintf(&key, "/%d=%s", k, labels[k])
+	}
+
+	if n, isa := g.byLabels[key.String()]; isa {
+		return n.name
+	}
+
+	name := goName(r.Name)
+	for _, n := range g.types {
+		if n.name == name {
+			name = fmt.Sprintf("%s%d", name, r.Address)
+			break
+		}
+	}
+
+	n := &named{name: name, base: base, labels: labels, bits: bits}
+	g.byLabels[key.String()] = n
+	g.types = append(g.types, n)
+
+	return name
+}
+
+func (g *generator) write(buf *bytes.Buffer, pkg string) {
+	fmt.Fprintln(buf, "// Code generated by sungrow-typegen. DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintf(buf, "package %s\n\n", pkg)
+	fmt.Fprintln(buf, `import "github.com/freman/sungrow"`)
+	fmt.Fprintln(buf)
+
+	types := append([]*named(nil), g.types...)
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].name < types[j].name
+	})
+
+	for _, n := range types {
+		writeNamed(buf, n)
+	}
+
+	for _, f := range g.families {
+		writeFamily(buf, f)
+	}
+
+	fmt.Fprintln(buf, "var constructors = map[string]func() Device{")
+	for _, f := range g.families {
+		for _, m := range f.Models {
+			fmt.Fprintf(buf, "%q: func() Device { return new(%s) },\n", m, f.Name)
+		}
+	}
+	fmt.Fprintln(buf, "}")
+}
+
+func writeNamed(buf *bytes.Buffer, n *named) {
+	values := make([]int, 0, len(n.labels))
+	for v := range n.labels {
+		values = append(values, v)
+	}
+	sort.Ints(values)
+
+	if n.bits {
+		fmt.Fprintf(buf, "// %s is a set of flags.\n", n.name)
+	} else {
+		fmt.Fprintf(buf, "// %s is an enumerated value.\n", n.name)
+	}
+	fmt.Fprintf(buf, "type %s %s\n\n", n.name, n.base)
+
+	fmt.Fprintln(buf, "const (")
+	taken := map[string]bool{}
+	for _, v := range values {
+		name := n.name + goName(n.labels[v])
+		if taken[name] || name == n.name {
+			name = fmt.Sprintf("%s%d", name, v)
+		}
+		taken[name] = true
+
+		if n.bits {
+			fmt.Fprintf(buf, "%s %s = 0x%X\n", name, n.name, v)
+		} else {
+			fmt.Fprintf(buf, "%s %s = %d\n", name, n.name, v)
+		}
+	}
+	fmt.Fprintln(buf, ")")
+	fmt.Fprintln(buf)
+
+	lower := unexported(n.name)
+	fmt.Fprintf(buf, "var %sLabels = map[int]string{\n", lower)
+	for _, v := range values {
+		fmt.Fprintf(buf, "%d: %q,\n", v, n.labels[v])
+	}
+	fmt.Fprintln(buf, "}")
+	fmt.Fprintln(buf)
+
+	if n.bits {
+		fmt.Fprintf(buf, "// Has reports whether all the flags are set.\n")
+		fmt.Fprintf(buf, "func (v %s) Has(flags %s) bool { return v&flags == flags }\n\n", n.name, n.name)
+		fmt.Fprintf(buf, "// Flags returns the labels of the flags set, lowest first.\n")
+		fmt.Fprintf(buf, "func (v %s) Flags() []string { return flags(int(v), %sLabels) }\n\n", n.name, lower)
+		fmt.Fprintf(buf, "func (v %s) String() string { return join(v.Flags()) }\n\n", n.name)
+		return
+	}
+
+	fmt.Fprintf(buf, "func (v %s) String() string { return enum(%q, int(v), %sLabels) }\n\n", n.name, n.name, lower)
+}
+
+func writeFamily(buf *bytes.Buffer, f *family) {
+	fmt.Fprintf(buf, "// %s has the registers of the %s.\n", f.Name, list(f.Models))
+	fmt.Fprintf(buf, "type %s struct {\n", f.Name)
+	for _, field := range f.Fields {
+		r := field.Register
+		bank := "input"
+		if field.Holding {
+			bank = "holding"
+		}
+
+		tag := fmt.Sprintf(`register:"%s,%s,%d"`, r.Name, bank, r.Address)
+		if unit := r.GetUnit(); unit != "" {
+			tag += fmt.Sprintf(` unit:%q`, unit)
+		}
+		fmt.Fprintf(buf, "%s %s `%s`\n", field.Name, field.Type, tag)
+	}
+	fmt.Fprintln(buf, "}")
+	fmt.Fprintln(buf)
+
+	fmt.Fprintf(buf, "// Models returns the models with the registers of %s.\n", f.Name)
+	fmt.Fprintf(buf, "func (%s) Models() []string {\n", f.Name)
+	fmt.Fprintf(buf, "return %#v\n", f.Models)
+	fmt.Fprintln(buf, "}")
+	fmt.Fprintln(buf)
+
+	fmt.Fprintln(buf, "// Read reads the registers from the device, those it refuses are left as they were.")
+	fmt.Fprintf(buf, "func (d *%s) Read(client sungrow.Modbus) error {\n", f.Name)
+	fmt.Fprintln(buf, "return read(client, []field{")
+	for _, field := range f.Fields {
+		r := field.Register
+		fmt.Fprintf(buf, "{%t, sungrow.Register{Address: %d, Type: %q, Scale: %v, Count: %d}, func(r *sungrow.Register) { d.%s = %s }},\n",
+			field.Holding, r.Address, r.Type, r.Scale, r.Count, field.Name, conversion(field.Type))
+	}
+	fmt.Fprintln(buf, "})")
+	fmt.Fprintln(buf, "}")
+	fmt.Fprintln(buf)
+}
+
+func conversion(typ string) string {
+	switch typ {
+	case "string":
+		return "text(r)"
+	case "float64":
+		return "number(r)"
+	}
+	return typ + "(number(r))"
+}
+
+func list(models []string) string {
+	if len(models) == 1 {
+		return models[0]
+	}
+	return strings.Join(models[:len(models)-1], ", ") + " and " + models[len(models)-1]
+}
+
+// label is the name of a value, device_type_code values are maps
+func label(v interface{}) string {
+	if m, isa := v.(map[string]interface{}); isa {
+		if name, isa := m["name"].(string); isa {
+			return name
+		}
+	}
+	return fmt.Sprint(v)
+}
+
+// Words written in capitals
+var initialisms = map[string]string{
+	"ac": "AC", "arm": "ARM", "bms": "BMS", "dc": "DC", "dsp": "DSP", "ems": "EMS",
+	"id": "ID", "mppt": "MPPT", "pid": "PID", "pv": "PV", "soc": "SOC", "soh": "SOH",
+}
+
+// goName makes an exported identifier of a register name, model or label
+func goName(s string) string {
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(s, func(r rune) bool {
+		return r > unicode.MaxASCII || !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '.'
+	}) {
+		if up, isa := initialisms[strings.ToLower(word)]; isa {
+			b.WriteString(up)
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	// Model numbers keep their decimal point as a d, SH5.0RT is SH5d0RT
+	name := strings.ReplaceAll(b.String(), ".", "d")
+	if name == "" || unicode.IsDigit(rune(name[0])) || name[0] == '_' {
+		name = "X" + name
+	}
+	return name
+}
+
+// unexported lowers the leading word of an identifier, initialisms and all
+func unexported(name string) string {
+	i := 1
+	for i < len(name) && unicode.IsUpper(rune(name[i])) {
+		i++
+	}
+	// The last capital of a run starts the next word
+	if i > 1 && i < len(name) && unicode.IsLower(rune(name[i])) {
+		i--
+	}
+	return strings.ToLower(name[:i]) + name[i:]
+}
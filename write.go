@@ -0,0 +1,188 @@
+package sungrow
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ModbusWriter can read and write holding registers.
+type ModbusWriter interface {
+	Modbus
+	WriteSingleRegister(address, value uint16) (results []byte, err error)
+	WriteMultipleRegisters(address, quantity uint16, value []byte) (results []byte, err error)
+}
+
+// ErrVerify is returned when a register doesn't read back what was written.
+var ErrVerify = errors.New("register did not read back the written value")
+
+// Encode turns a value into the raw bytes for the register, the value may be
+// a number in the register's units or one of its value labels.
+func (r *Register) Encode(value interface{}) ([]byte, error) {
+	var v float64
+
+	switch x := value.(type) {
+	case string:
+		found := false
+		for raw, label := range r.Values {
+			if s, isa := label.(string); isa && s == x {
+				v, found = float64(raw), true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("%q is not a value of %s", x, r.Name)
+		}
+
+		// Labels are raw values, undo the scaling below
+		v *= r.Scale
+	case float64:
+		v = x
+	case float32:
+		v = float64(x)
+	case int:
+		v = float64(x)
+	case int32:
+		v = float64(x)
+	case int64:
+		v = float64(x)
+	case uint16:
+		v = float64(x)
+	case uint32:
+		v = float64(x)
+	default:
+		return nil, fmt.Errorf("can't encode %T to %s", value, r.Name)
+	}
+
+	if r.Min != nil && v < *r.Min || r.Max != nil && v > *r.Max {
+		return nil, fmt.Errorf("%v is out of range for %s", v, r.Name)
+	}
+
+	scale := r.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	raw := math.Round(v / scale)
+
+	var words []uint16
+	switch r.Type {
+	case "int16":
+		if raw < math.MinInt16 || raw > math.MaxInt16 {
+			return nil, fmt.Errorf("%v is out of range for %s", v, r.Name)
+		}
+		words = []uint16{uint16(int16(raw))}
+	case "uint16", "":
+		if raw < 0 || raw > math.MaxUint16 {
+			return nil, fmt.Errorf("%v is out of range for %s", v, r.Name)
+		}
+		words = []uint16{uint16(raw)}
+	case "int32":
+		if raw < math.MinInt32 || raw > math.MaxInt32 {
+			return nil, fmt.Errorf("%v is out of range for %s", v, r.Name)
+		}
+		u := uint32(int32(raw))
+		words = []uint16{uint16(u), uint16(u >> 16)}
+	case "uint32":
+		if raw < 0 || raw > math.MaxUint32 {
+			return nil, fmt.Errorf("%v is out of range for %s", v, r.Name)
+		}
+		u := uint32(raw)
+		words = []uint16{uint16(u), uint16(u >> 16)}
+	default:
+		return nil, fmt.Errorf("can't write %s registers", r.Type)
+	}
+
+	b := make([]byte, 0, len(words)*2)
+	for _, w := range words {
+		b = append(b, byte(w>>8), byte(w))
+	}
+
+	return b, nil
+}
+
+// Holding returns the named holding register for the model, preferring one
+// specific to the model over one for every model.
+func (i *Inverter) Holding(name string) *Register {
+	model := i.Model()
+
+	var r *Register
+	for idx := range i.Registers.Holding {
+		h := &i.Registers.Holding[idx]
+		if h.Name != name || !h.Models.ContainsOrNull(model) {
+			continue
+		}
+
+		if r == nil || len(h.Models) > 0 && len(r.Models) == 0 {
+			r = h
+		}
+	}
+
+	return r
+}
+
+// Write sets the named holding register and verifies it by reading it back.
+func (i *Inverter) Write(client ModbusWriter, name string, value interface{}) error {
+	r := i.Holding(name)
+	if r == nil {
+		return fmt.Errorf("no holding register %q for %q", name, i.Model())
+	}
+
+	return i.write(client, r, value)
+}
+
+func (i *Inverter) write(client ModbusWriter, r *Register, value interface{}) error {
+	raw, err := r.Encode(value)
+	if err != nil {
+		return err
+	}
+
+	address := uint16(r.Address - 1)
+	quantity := uint16(len(raw) / 2)
+
+	if quantity == 1 {
+		_, err = client.WriteSingleRegister(address, uint16(raw[0])<<8|uint16(raw[1]))
+	} else {
+		_, err = client.WriteMultipleRegisters(address, quantity, raw)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", r.Name, err)
+	}
+
+	results, err := client.ReadHoldingRegisters(address, quantity)
+	if err != nil {
+		return fmt.Errorf("failed to read back %s: %w", r.Name, err)
+	}
+
+	r.model = i.Model()
+	r.Supported, r.Err = true, nil
+	r.read(bytes.NewReader(results))
+
+	if !bytes.Equal(results, raw) {
+		return fmt.Errorf("%w: %s wrote % X read % X", ErrVerify, r.Name, raw, results)
+	}
+
+	return nil
+}
+
+// RestoreDefaults writes the default of every holding register that declares
+// one and applies to the model, carrying on past failures.
+func (i *Inverter) RestoreDefaults(client ModbusWriter) error {
+	model := i.Model()
+
+	var first error
+	for idx := range i.Registers.Holding {
+		r := &i.Registers.Holding[idx]
+		if r.Default == nil || !r.Models.ContainsOrNull(model) {
+			continue
+		}
+
+		if err := i.write(client, r, *r.Default); err != nil && first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
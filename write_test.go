@@ -0,0 +1,126 @@
+package sungrow_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/freman/sungrow"
+	"github.com/freman/sungrow/internal/modbustest"
+	"github.com/stretchr/testify/require"
+)
+
+const writeRegisters = `registers:
+  input:
+    - address: 5000
+      name: "device_type_code"
+      values:
+        0xE03:
+          hybrid: true
+          name: "SH10RT"
+  holding:
+    - address: 13050
+      name: "ems_mode_selection"
+      values:
+        0: "Self-consumption mode"
+        2: "Forced mode"
+      default: 0
+    - address: 13051
+      name: "charge_discharge_command"
+      values:
+        0xAA: "Charge"
+        0xCC: "Stop"
+      default: 0xCC
+    - address: 13052
+      name: "charge_discharge_power"
+      min: 0
+      max: 5000
+      default: 1000
+      unit: "W"
+    - address: 13058
+      name: "max_soc"
+      min: 70.0
+      max: 100.0
+      unit: "%"
+      scale: 0.1
+    - address: 13080
+      name: "export_limit"
+      type: "int32"
+      models: ["SH10RT"]
+    - address: 13100
+      name: "not_here"
+      models: ["SH5.0RS"]
+`
+
+func TestEncode(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(writeRegisters)))
+
+	holding := inv.Registers.Holding
+
+	b, err := holding[0].Encode("Forced mode")
+	requires.NoError(err)
+	requires.Equal([]byte{0, 2}, b)
+
+	b, err = holding[3].Encode(95.5)
+	requires.NoError(err)
+	requires.Equal([]byte{0x03, 0xBB}, b)
+
+	// Low word first
+	b, err = holding[4].Encode(-2)
+	requires.NoError(err)
+	requires.Equal([]byte{0xFF, 0xFE, 0xFF, 0xFF}, b)
+
+	_, err = holding[3].Encode(50)
+	requires.Error(err)
+	_, err = holding[0].Encode("Party mode")
+	requires.Error(err)
+	_, err = holding[0].Encode(true)
+	requires.Error(err)
+}
+
+func TestWrite(t *testing.T) {
+	requires := require.New(t)
+
+	var inv sungrow.Inverter
+	requires.NoError(inv.Define(strings.NewReader(writeRegisters)))
+
+	client := &modbustest.Device{
+		Input: map[uint16]uint16{4999: 0xE03},
+		Holding: map[uint16]uint16{
+			13049: 0, 13050: 0xCC, 13051: 1000, 13057: 1000, 13079: 0, 13080: 0, 13099: 0,
+		},
+		Ignored: map[uint16]bool{},
+	}
+	requires.NoError(inv.Read(client))
+
+	requires.NoError(inv.Write(client, "ems_mode_selection", sungrow.EMSModeForced))
+	requires.NoError(inv.Write(client, "charge_discharge_command", "Charge"))
+	requires.NoError(inv.Write(client, "charge_discharge_power", 3000.0))
+	requires.NoError(inv.Write(client, "export_limit", -5000))
+
+	requires.Equal(uint16(2), client.Holding[13049])
+	requires.Equal(uint16(0xAA), client.Holding[13050])
+	requires.Equal(uint16(3000), client.Holding[13051])
+	requires.Equal(uint16(0xEC78), client.Holding[13079])
+	requires.Equal(uint16(0xFFFF), client.Holding[13080])
+
+	// The register reflects what was read back
+	requires.Equal("Charge", inv.Registers.Holding[1].Value)
+	requires.Equal(-5000.0, inv.Registers.Holding[4].Value)
+
+	requires.Error(inv.Write(client, "charge_discharge_power", 6000.0))
+	requires.Error(inv.Write(client, "not_here", 1))
+	requires.Error(inv.Write(client, "nope", 1))
+
+	client.Ignored[13051] = true
+	requires.ErrorIs(inv.Write(client, "charge_discharge_power", 2000.0), sungrow.ErrVerify)
+
+	// Defaults are restored past failures
+	client.Writes = 0
+	requires.ErrorIs(inv.RestoreDefaults(client), sungrow.ErrVerify)
+	requires.Equal(3, client.Writes)
+	requires.Equal(uint16(0), client.Holding[13049])
+	requires.Equal(uint16(0xCC), client.Holding[13050])
+}